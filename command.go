@@ -0,0 +1,83 @@
+package starr
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+/* This file contains the command status type and the polling loop used by
+ * WaitForCommand and SendCommandAndWait in the app packages.
+ */
+
+// CommandStatus is the status of a command. Every app uses these same values.
+type CommandStatus string
+
+// Command statuses. Only queued and started are in progress; the rest are final.
+const (
+	CommandQueued    CommandStatus = "queued"
+	CommandStarted   CommandStatus = "started"
+	CommandCompleted CommandStatus = "completed"
+	CommandFailed    CommandStatus = "failed"
+	CommandAborted   CommandStatus = "aborted"
+	CommandCancelled CommandStatus = "cancelled"
+	CommandOrphaned  CommandStatus = "orphaned"
+)
+
+// Defaults for command polling. The interval starts at the minimum and doubles until the maximum.
+const (
+	DefaultCommandPollMin = 500 * time.Millisecond
+	DefaultCommandPollMax = 10 * time.Second
+)
+
+// Done returns true if the command is no longer queued or running.
+// Unknown (and empty) statuses are final too, so a polling loop never runs forever.
+func (s CommandStatus) Done() bool {
+	return s != CommandQueued && s != CommandStarted
+}
+
+// CommandResult is returned by the WaitForCommand and SendCommandAndWait methods in the app packages.
+// Command is the last response received; it's nil only if the first poll failed.
+type CommandResult[T any] struct {
+	Command  *T
+	Status   CommandStatus
+	Duration time.Duration // How long we waited for the command.
+}
+
+// WaitForCommand calls get until the returned status is final, waiting longer between each call.
+// This is the polling loop used by the app packages; you probably want one of those methods.
+// If the context is cancelled (or get fails) the last known status is returned with the error.
+// A failed or aborted command is not an error; check the Status.
+func WaitForCommand[T any](
+	ctx context.Context,
+	get func(context.Context) (*T, CommandStatus, error),
+) (*CommandResult[T], error) {
+	start := time.Now()
+	result := &CommandResult[T]{}
+	backoff := &RetryPolicy{MinWait: DefaultCommandPollMin, MaxWait: DefaultCommandPollMax, NoJitter: true}
+
+	for attempt := 1; ; attempt++ {
+		command, status, err := get(ctx)
+		result.Duration = time.Since(start)
+
+		if err != nil {
+			return result, err
+		}
+
+		result.Command, result.Status = command, status
+		if status.Done() {
+			return result, nil
+		}
+
+		timer := time.NewTimer(backoff.Backoff(attempt, 0))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			result.Duration = time.Since(start)
+
+			return result, fmt.Errorf("waiting for command: %w", ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
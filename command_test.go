@@ -0,0 +1,70 @@
+package starr_test
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/starrtest"
+)
+
+type testCommand struct {
+	Polls int
+}
+
+func TestWaitForCommandCancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+	defer cancel()
+
+	command := &testCommand{}
+	result, err := starr.WaitForCommand(ctx, func(context.Context) (*testCommand, starr.CommandStatus, error) {
+		command.Polls++
+		return command, starr.CommandStarted, nil
+	})
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, starr.CommandStarted, result.Status, "the last known status must be returned")
+	assert.Equal(t, 1, result.Command.Polls)
+	assert.GreaterOrEqual(t, result.Duration, 100*time.Millisecond)
+}
+
+func TestCommandStatusDone(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, starr.CommandQueued.Done())
+	assert.False(t, starr.CommandStarted.Done())
+	assert.True(t, starr.CommandCompleted.Done())
+	assert.True(t, starr.CommandFailed.Done())
+	assert.True(t, starr.CommandAborted.Done())
+	assert.True(t, starr.CommandStatus("").Done())
+}
+
+// TestCommandStatusSpec makes sure every command status in the bundled specs is known.
+func TestCommandStatusSpec(t *testing.T) {
+	t.Parallel()
+
+	specs, _ := filepath.Glob(filepath.Join("specs", "*.json"))
+	require.NotEmpty(t, specs)
+
+	known := []starr.CommandStatus{
+		starr.CommandQueued, starr.CommandStarted, starr.CommandCompleted, starr.CommandFailed,
+		starr.CommandAborted, starr.CommandCancelled, starr.CommandOrphaned,
+	}
+
+	for _, specFile := range specs {
+		spec, err := starrtest.LoadSpec(specFile)
+		require.NoError(t, err)
+		require.Contains(t, spec.Components.Schemas, "CommandStatus", specFile)
+
+		for _, status := range spec.Components.Schemas["CommandStatus"].Enum {
+			assert.Contains(t, known, starr.CommandStatus(fmt.Sprint(status)), specFile)
+		}
+	}
+}
@@ -0,0 +1,303 @@
+package debuglog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CassetteVersion is the current cassette file format version.
+const CassetteVersion = 1
+
+// redacted replaces secrets in cassettes and logs.
+const redacted = "<redacted>"
+
+// These headers are always scrubbed from cassettes.
+//
+//nolint:gochecknoglobals // this is a read-only list.
+var secretHeaders = []string{"X-Api-Key", "Authorization", "Cookie", "Set-Cookie"}
+
+// JSON properties and provider fields with names ending in these are always scrubbed from cassettes.
+//
+//nolint:gochecknoglobals // this is a read-only list.
+var secretNames = []string{"apikey", "password", "passkey", "passphrase", "token", "secret"}
+
+// Cassette is a list of recorded HTTP exchanges. It's saved as JSON.
+// Create one with a RecordingRoundTripper, and replay it with starrtest.NewReplayRoundTripper.
+type Cassette struct {
+	Version      int            `json:"version"`
+	Recorded     time.Time      `json:"recorded"`
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a single recorded request and its response.
+type Interaction struct {
+	Request  *RecordedRequest  `json:"request"`
+	Response *RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the request part of an Interaction.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"` // Path and query only, without scheme and host.
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is the response part of an Interaction.
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Status     string      `json:"status"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// RecordingRoundTripper saves every request and response to a cassette.
+// API keys, auth headers, cookies, secret JSON properties and provider fields, and the Config.Redact
+// values are scrubbed before they're saved. The API key sent with each request is scrubbed everywhere.
+// Call Save when you're done, and attach the file to a bug report.
+type RecordingRoundTripper struct {
+	next     http.RoundTripper
+	config   *Config
+	mu       sync.Mutex
+	cassette *Cassette
+}
+
+// NewRecordingRoundTripper returns a round tripper that records every exchange.
+// Only Redact is used from the config. If next is nil, http.DefaultTransport is used.
+func NewRecordingRoundTripper(config Config, next http.RoundTripper) *RecordingRoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &RecordingRoundTripper{
+		next:     next,
+		config:   &config,
+		cassette: &Cassette{Version: CassetteVersion, Recorded: time.Now().UTC(), Interactions: []*Interaction{}},
+	}
+}
+
+// RoundTrip satisfies the http.RoundTripper interface.
+func (rt *RecordingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var sent []byte
+
+	if req.Body != nil {
+		var err error
+		if sent, err = io.ReadAll(req.Body); err != nil {
+			return nil, fmt.Errorf("reading request body: %w", err)
+		}
+
+		_ = req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(sent))
+	}
+
+	resp, err := rt.next.RoundTrip(req)
+	if err != nil {
+		return resp, err //nolint:wrapcheck
+	}
+
+	rcvd, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(rcvd))
+
+	if err != nil {
+		return resp, fmt.Errorf("reading response body: %w", err)
+	}
+
+	config := rt.config.withKey(req)
+
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	rt.cassette.Interactions = append(rt.cassette.Interactions, &Interaction{
+		Request: &RecordedRequest{
+			Method: req.Method,
+			URL:    config.redact(scrubURL(req.URL)),
+			Header: config.scrubHeader(req.Header),
+			Body:   config.redact(scrubJSON(sent)),
+		},
+		Response: &RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+			Header:     config.scrubHeader(resp.Header),
+			Body:       config.redact(scrubJSON(rcvd)),
+		},
+	})
+
+	return resp, nil
+}
+
+// Cassette returns the recorded exchanges so far.
+func (rt *RecordingRoundTripper) Cassette() *Cassette {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	cassette := *rt.cassette
+	cassette.Interactions = append([]*Interaction{}, rt.cassette.Interactions...)
+
+	return &cassette
+}
+
+// Save writes the recorded exchanges to a cassette file.
+func (rt *RecordingRoundTripper) Save(path string) error {
+	data, err := json.MarshalIndent(rt.Cassette(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding cassette: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o600); err != nil { //nolint:mnd
+		return fmt.Errorf("writing cassette: %w", err)
+	}
+
+	return nil
+}
+
+// LoadCassette reads a cassette file.
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading cassette: %w", err)
+	}
+
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("decoding cassette %s: %w", path, err)
+	}
+
+	return &cassette, nil
+}
+
+// withKey returns a copy of the config that also redacts the API key sent with a request.
+// The apps echo the key in some bodies, like the host config.
+func (c *Config) withKey(req *http.Request) *Config {
+	output := *c
+	output.Redact = append([]string{}, c.Redact...)
+
+	for _, key := range []string{req.Header.Get("X-Api-Key"), req.URL.Query().Get("apikey")} {
+		if key != "" {
+			output.Redact = append(output.Redact, key)
+		}
+	}
+
+	return &output
+}
+
+// scrubURL returns the path and query of a URL, without an apikey parameter.
+func scrubURL(input *url.URL) string {
+	query := input.Query()
+	if query.Has("apikey") {
+		query.Set("apikey", redacted)
+	}
+
+	output := url.URL{Path: input.Path, RawQuery: query.Encode()}
+
+	return output.String()
+}
+
+// scrubHeader returns a copy of the headers with secrets and redacted values removed.
+func (c *Config) scrubHeader(input http.Header) http.Header {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := make(http.Header, len(input))
+
+	for key, values := range input {
+		for _, value := range values {
+			output.Add(key, c.redact(value))
+		}
+	}
+
+	for _, key := range secretHeaders {
+		if output.Get(key) != "" {
+			output.Set(key, redacted)
+		}
+	}
+
+	return output
+}
+
+// redact replaces every Redact value in a string.
+func (c *Config) redact(input string) string {
+	for _, secret := range c.Redact {
+		if len(secret) >= minRedactChars {
+			input = strings.ReplaceAll(input, secret, redacted)
+		}
+	}
+
+	return input
+}
+
+// scrubJSON returns a JSON body with secret properties and secret provider fields redacted.
+// Bodies that are not JSON, or have no secrets, are returned as they are.
+func scrubJSON(body []byte) string {
+	var data any
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	if decoder.Decode(&data) != nil || !scrubValue(data) {
+		return string(body)
+	}
+
+	output, err := json.Marshal(data)
+	if err != nil {
+		return string(body)
+	}
+
+	return string(output)
+}
+
+// scrubValue redacts secrets in decoded JSON, and returns true if it found any.
+// A secret is a property like apiKey or password, or the value of a provider field with password
+// or apiKey privacy, or named like a secret.
+func scrubValue(data any) bool {
+	found := false
+
+	switch data := data.(type) {
+	case []any:
+		for _, item := range data {
+			found = scrubValue(item) || found
+		}
+	case map[string]any:
+		name, _ := data["name"].(string)
+		privacy, _ := data["privacy"].(string)
+
+		if value, ok := data["value"].(string); ok && value != "" &&
+			(privacy == "password" || privacy == "apiKey" || secretName(name)) {
+			data["value"] = redacted
+			found = true
+		}
+
+		for key, value := range data {
+			if str, ok := value.(string); ok && str != "" && secretName(key) {
+				data[key] = redacted
+				found = true
+			} else {
+				found = scrubValue(value) || found
+			}
+		}
+	}
+
+	return found
+}
+
+// secretName returns true if a property or field name holds a secret.
+func secretName(name string) bool {
+	name = strings.ToLower(name)
+
+	for _, secret := range secretNames {
+		if strings.HasSuffix(name, secret) {
+			return true
+		}
+	}
+
+	return false
+}
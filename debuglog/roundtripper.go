@@ -168,13 +168,5 @@ func (f *fakeCloser) headers() string {
 }
 
 func (f *fakeCloser) redactLog(msg string, format ...any) {
-	msg = fmt.Sprintf(msg, format...)
-
-	for _, redact := range f.Redact {
-		if len(redact) >= minRedactChars {
-			msg = strings.ReplaceAll(msg, redact, "<redacted>")
-		}
-	}
-
-	f.Debugf(msg)
+	f.Debugf(f.redact(fmt.Sprintf(msg, format...)))
 }
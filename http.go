@@ -10,7 +10,9 @@ import (
 	"net/url"
 	"path"
 	"reflect"
+	"slices"
 	"strings"
+	"time"
 )
 
 // API is the beginning of every API path.
@@ -36,6 +38,13 @@ type ReqError struct {
 	Name string
 	Body []byte
 	Code int
+	// Errors contains every validation failure when the app returns property errors.
+	// Name and Msg are copied from the first one. Use ValidationErrors() or errors.As to get them.
+	Errors ValidationErrors
+	// Attempts is the number of requests made before this error was returned.
+	// This is greater than 1 only when Config.Retry is set and the request was retried.
+	// Network errors do not have a status code; those return a *RetryError after more than one attempt.
+	Attempts int
 }
 
 // String turns a request into a string. Usually used in error messages.
@@ -56,13 +65,68 @@ func (c *Config) api(ctx context.Context, method string, req Request) (*http.Res
 }
 
 // req is our abstraction method for calling a starr application.
+// If the config has a retry policy, idempotent requests are retried here.
 func (c *Config) req(ctx context.Context, method string, req Request) (*http.Response, error) {
 	if c.Client == nil { // we must have an http client.
 		return nil, ErrNilClient
 	}
 
+	attempts := c.Retry.attempts(method)
+	if attempts > 1 {
+		// The body must be buffered so it can be sent again.
+		if err := bufferBody(&req); err != nil {
+			return nil, err
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 {
+			rewindBody(&req)
+		}
+
+		release, err := c.Limiter.Wait(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := c.do(ctx, method, req, release)
+
+		var retryAfter time.Duration
+
+		switch {
+		case err != nil && (attempt >= attempts || !retryable(ctx, err)):
+			if attempt > 1 {
+				return nil, &RetryError{Attempts: attempt, Err: err}
+			}
+
+			return nil, fmt.Errorf("httpClient.Do(req): %w", err)
+		case err != nil:
+			// Network error; try again after the backoff.
+		case resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices:
+			reqErr := parseNon200(resp)
+			reqErr.Attempts = attempt
+
+			if attempt >= attempts || !c.Retry.retryStatus(resp.StatusCode) {
+				return nil, reqErr
+			}
+
+			retryAfter = parseRetryAfter(reqErr.Header, time.Now())
+		default:
+			return resp, nil
+		}
+
+		if err := sleepCtx(ctx, c.Retry.Backoff(attempt, retryAfter)); err != nil {
+			return nil, fmt.Errorf("waiting to retry %s: %w", &req, err)
+		}
+	}
+}
+
+// do makes a single http request to a starr application.
+// The release function is called when the response body is closed, or right away on error.
+func (c *Config) do(ctx context.Context, method string, req Request, release func()) (*http.Response, error) {
 	httpReq, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(c.URL, "/")+req.URI, req.Body)
 	if err != nil {
+		release()
 		return nil, fmt.Errorf("http.NewRequestWithContext(%s): %w", req.URI, err)
 	}
 
@@ -74,12 +138,11 @@ func (c *Config) req(ctx context.Context, method string, req Request) (*http.Res
 
 	resp, err := c.Client.Do(httpReq)
 	if err != nil {
-		return nil, fmt.Errorf("httpClient.Do(req): %w", err)
+		release()
+		return nil, err //nolint:wrapcheck // the caller wraps this.
 	}
 
-	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
-		return nil, parseNon200(resp)
-	}
+	resp.Body = &releaseBody{ReadCloser: resp.Body, release: release}
 
 	return resp, nil
 }
@@ -102,29 +165,41 @@ func parseNon200(resp *http.Response) *ReqError {
 		return response
 	}
 
-	type propError struct {
-		Msg  string `json:"errorMessage"`
-		Name string `json:"propertyName"`
-	}
+	var errMsg ValidationError
 
-	var errMsg propError
+	if response.Err = json.Unmarshal(response.Body, &errMsg); response.Err == nil && errMsg.ErrorMessage != "" {
+		response.Name, response.Msg = errMsg.PropertyName, errMsg.ErrorMessage
+		response.Errors = ValidationErrors{&errMsg}
 
-	if response.Err = json.Unmarshal(response.Body, &errMsg); response.Err == nil && errMsg.Msg != "" {
-		response.Name, response.Msg = errMsg.Name, errMsg.Msg
 		return response
 	}
 
-	// Sometimes we get a list of errors. This grabs the first one.
-	var errMsg2 []propError
+	// Sometimes we get a list of errors. Keep them all, and use the first one for Name and Msg.
+	// Any other JSON array is not a list of validation errors; leave it in the Body.
+	var errMsg2 ValidationErrors
 
-	if response.Err = json.Unmarshal(response.Body, &errMsg2); response.Err == nil && len(errMsg2) > 0 {
-		response.Name, response.Msg = errMsg2[0].Name, errMsg2[0].Msg
-		return response
+	if response.Err = json.Unmarshal(response.Body, &errMsg2); response.Err == nil {
+		errMsg2 = slices.DeleteFunc(errMsg2, func(err *ValidationError) bool { return err == nil })
+		if first := firstValidationError(errMsg2); first != nil {
+			response.Name, response.Msg = first.PropertyName, first.ErrorMessage
+			response.Errors = errMsg2
+		}
 	}
 
 	return response
 }
 
+// firstValidationError returns the first error in the list with a message or property name.
+func firstValidationError(errs ValidationErrors) *ValidationError {
+	for _, err := range errs {
+		if err.ErrorMessage != "" || err.PropertyName != "" {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // closeResp should be used to close requests that don't require a response body.
 func closeResp(resp *http.Response) {
 	if resp != nil && resp.Body != nil {
@@ -181,7 +256,13 @@ func (r *ReqError) Error() string {
 		msg = fmt.Sprintf("%s, %d >= %d", prefix, r.Code, http.StatusMultipleChoices)
 	}
 
+	if r.Attempts > 1 {
+		msg = fmt.Sprintf("%s (%d attempts)", msg, r.Attempts)
+	}
+
 	switch body := string(r.Body); {
+	case len(r.Errors) > 1:
+		return fmt.Sprintf("%s, %s", msg, r.Errors.Error())
 	case r.Name != "":
 		return fmt.Sprintf("%s, %s: %s", msg, r.Name, r.Msg)
 	case r.Msg != "":
@@ -1,8 +1,11 @@
 package starr_test
 
 import (
+	"context"
 	"net/http"
+	"net/http/httptest"
 	"path"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -52,3 +55,109 @@ func TestReqError(t *testing.T) {
 	err.Name = "Varname"
 	assert.Equal(t, "invalid status code, 403 >= 300, Varname: Some message", err.Error())
 }
+
+func TestReqErrorValidation(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		writer.WriteHeader(http.StatusBadRequest)
+		_, _ = writer.Write([]byte(`[{"propertyName":"Name","errorMessage":"Should be unique","attemptedValue":"HD",` +
+			`"severity":"error","errorCode":"UniqueValidator"},{"propertyName":"Cutoff","errorMessage":` +
+			`"Must be allowed","attemptedValue":7,"severity":"error","errorCode":"PredicateValidator"}]`))
+	}))
+	defer server.Close()
+
+	config := starr.New("apiKey", server.URL, 0)
+	err := config.PutInto(context.Background(), starr.Request{URI: "/v3/qualityprofile/1"}, &struct{}{})
+	require.ErrorIs(t, err, starr.ErrInvalidStatusCode)
+	assert.Equal(t, "invalid status code, 400 >= 300, Name: Should be unique; Cutoff: Must be allowed", err.Error())
+
+	var reqErr *starr.ReqError
+
+	require.ErrorAs(t, err, &reqErr)
+	assert.Equal(t, "Name", reqErr.Name)
+	assert.Equal(t, "Should be unique", reqErr.Msg)
+	require.Len(t, reqErr.ValidationErrors(), 2)
+	assert.Equal(t, "PredicateValidator", reqErr.ValidationErrors()[1].ErrorCode)
+	assert.InDelta(t, 7, reqErr.ValidationErrors()[1].AttemptedValue, 0)
+
+	var all starr.ValidationErrors
+
+	require.ErrorAs(t, err, &all)
+	assert.Len(t, all, 2)
+	assert.Equal(t, all, starr.GetValidationErrors(err))
+
+	var first *starr.ValidationError
+
+	require.ErrorAs(t, err, &first)
+	assert.Equal(t, "HD", first.AttemptedValue)
+	assert.Equal(t, "[error] Cutoff: Must be allowed (attempted value: 7) [PredicateValidator]\n",
+		all[1:].String())
+}
+
+func TestReqErrorArray(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		writer.WriteHeader(http.StatusConflict)
+		_, _ = writer.Write([]byte(`[{"id":1,"title":"one"},{"id":2,"title":"two"}]`))
+	}))
+	defer server.Close()
+
+	config := starr.New("apiKey", server.URL, 0)
+	err := config.PutInto(context.Background(), starr.Request{URI: "/v3/queue/bulk"}, &struct{}{})
+	require.ErrorIs(t, err, starr.ErrInvalidStatusCode)
+	assert.Equal(t, `invalid status code, 409 >= 300, [{"id":1,"title":"one"},{"id":2,"title":"two"}]`, err.Error())
+
+	var reqErr *starr.ReqError
+
+	require.ErrorAs(t, err, &reqErr)
+	assert.Empty(t, reqErr.Name)
+	assert.Empty(t, reqErr.Msg)
+	assert.Empty(t, reqErr.ValidationErrors(), "an array of other objects is not a list of validation errors")
+	assert.Empty(t, starr.GetValidationErrors(err))
+}
+
+func TestReqErrorArrayNull(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		writer.WriteHeader(http.StatusBadRequest)
+		_, _ = writer.Write([]byte(`[null,{"propertyName":"Path","errorMessage":"x"},null]`))
+	}))
+	defer server.Close()
+
+	config := starr.New("apiKey", server.URL, 0)
+	err := config.PutInto(context.Background(), starr.Request{URI: "/v3/rootfolder"}, &struct{}{})
+	require.ErrorIs(t, err, starr.ErrInvalidStatusCode)
+
+	errs := starr.GetValidationErrors(err)
+	assert.Equal(t, starr.ValidationErrors{{PropertyName: "Path", ErrorMessage: "x"}}, errs, "null entries are dropped")
+	assert.Equal(t, "Path: x", errs.Error())
+	assert.Equal(t, "[error] Path: x\n", errs.String())
+
+	// A list built by hand may still have nil entries.
+	errs = starr.ValidationErrors{nil, {ErrorMessage: "y"}}
+	assert.Equal(t, "y", errs.Error())
+	assert.Equal(t, "[error] y\n", errs.String())
+}
+
+func TestDownloadBackup(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/sonarr/backup/manual/sonarr_backup.zip", r.URL.Path, "the url base must be kept")
+		assert.Equal(t, "key", r.Header.Get("X-Api-Key"))
+		_, _ = w.Write([]byte("zip data"))
+	}))
+	t.Cleanup(server.Close)
+
+	var output strings.Builder
+
+	config := starr.New("key", server.URL+"/sonarr/", 0)
+	backup := &starr.BackupFile{Name: "sonarr_backup.zip", Path: "/backup/manual/sonarr_backup.zip"}
+	require.NoError(t, config.DownloadBackup(t.Context(), backup, &output))
+	assert.Equal(t, "zip data", output.String())
+
+	require.ErrorIs(t, config.DownloadBackup(t.Context(), &starr.BackupFile{}, &output), starr.ErrRequestError)
+}
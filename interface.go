@@ -161,6 +161,28 @@ func decode(output any, resp *http.Response, err error) error {
 	return nil
 }
 
+// DownloadBackup writes a backup archive, from GetBackupFiles in an app package, to a writer.
+// The archive is not in the API path; the API key works, or call Login() first.
+func (c *Config) DownloadBackup(ctx context.Context, backup *BackupFile, output io.Writer) error {
+	if backup == nil || backup.Path == "" {
+		return fmt.Errorf("%w: backup file has no path", ErrRequestError)
+	}
+
+	req := Request{URI: "/" + strings.TrimPrefix(backup.Path, "/")}
+
+	resp, err := c.Get(ctx, req)
+	if err != nil {
+		return fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+	defer resp.Body.Close()
+
+	if _, err := io.Copy(output, resp.Body); err != nil {
+		return fmt.Errorf("writing backup %s: %w", backup.Name, err)
+	}
+
+	return nil
+}
+
 // GetInitializeJS returns the data from the initialize.js file.
 // If the instance requires authentication, you must call Login() before this method.
 func (c *Config) GetInitializeJS(ctx context.Context) (*InitializeJS, error) {
@@ -41,6 +41,24 @@ type Album struct {
 	Monitored      bool             `json:"monitored"`
 	AnyReleaseOk   bool             `json:"anyReleaseOk"`
 	Grabbed        bool             `json:"grabbed"`
+	// Unknown holds JSON fields this library does not model, so an update sends them back unchanged.
+	Unknown starr.UnknownFields `json:"-"`
+}
+
+// UnmarshalJSON decodes an album, and saves any fields this library does not model in Unknown.
+func (a *Album) UnmarshalJSON(data []byte) error {
+	type album Album
+
+	unknown, err := starr.DecodeUnknown(data, (*album)(a))
+	a.Unknown = unknown
+
+	return err //nolint:wrapcheck // already wrapped.
+}
+
+// MarshalJSON encodes an album, including any fields saved in Unknown.
+func (a Album) MarshalJSON() ([]byte, error) {
+	type album Album
+	return starr.EncodeUnknown(album(a), a.Unknown) //nolint:wrapcheck // already wrapped.
 }
 
 // Release is part of an Album.
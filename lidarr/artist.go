@@ -60,6 +60,24 @@ type Artist struct {
 	AlbumFolder       bool              `json:"albumFolder,omitempty"`
 	Monitored         bool              `json:"monitored"`
 	Ended             bool              `json:"ended,omitempty"`
+	// Unknown holds JSON fields this library does not model, so an update sends them back unchanged.
+	Unknown starr.UnknownFields `json:"-"`
+}
+
+// UnmarshalJSON decodes an artist, and saves any fields this library does not model in Unknown.
+func (a *Artist) UnmarshalJSON(data []byte) error {
+	type artist Artist
+
+	unknown, err := starr.DecodeUnknown(data, (*artist)(a))
+	a.Unknown = unknown
+
+	return err //nolint:wrapcheck // already wrapped.
+}
+
+// MarshalJSON encodes an artist, including any fields saved in Unknown.
+func (a Artist) MarshalJSON() ([]byte, error) {
+	type artist Artist
+	return starr.EncodeUnknown(artist(a), a.Unknown) //nolint:wrapcheck // already wrapped.
 }
 
 // Statistics is part of Artist and Album.
@@ -0,0 +1,35 @@
+package lidarr_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr/lidarr"
+)
+
+// TestUnknownFieldsValue makes sure artists and albums keep unknown fields when marshaled as values.
+func TestUnknownFieldsValue(t *testing.T) {
+	t.Parallel()
+
+	var (
+		artist lidarr.Artist
+		album  lidarr.Album
+	)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"id":1,"monitored":true,"newField":"keep me"}`), &artist))
+	require.NoError(t, json.Unmarshal([]byte(`{"id":2,"title":"Album","newField":"keep me too"}`), &album))
+
+	output, err := json.Marshal([]lidarr.Artist{artist})
+	require.NoError(t, err)
+	assert.Contains(t, string(output), `"newField":"keep me"`)
+
+	output, err = json.Marshal(album)
+	require.NoError(t, err)
+	assert.Contains(t, string(output), `"newField":"keep me too"`)
+
+	output, err = json.Marshal(struct{ Artist lidarr.Artist }{artist})
+	require.NoError(t, err)
+	assert.Contains(t, string(output), `"newField":"keep me"`)
+}
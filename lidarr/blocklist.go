@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"path"
 	"time"
 
@@ -93,6 +94,19 @@ func (l *Lidarr) GetBlockListPageContext(ctx context.Context, params *starr.Page
 	return &output, nil
 }
 
+// BlockListAll returns an iterator over every Block List record from Lidarr.
+// Pages are fetched lazily as the loop runs, and the loop may break early. See starr.Paginate for more.
+func (l *Lidarr) BlockListAll(ctx context.Context, params *starr.PageReq) iter.Seq2[*BlockListRecord, error] {
+	return starr.Paginate(ctx, params, func(ctx context.Context, req *starr.PageReq) ([]*BlockListRecord, int, error) {
+		page, err := l.GetBlockListPageContext(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Records, page.TotalRecords, nil
+	})
+}
+
 // DeleteBlockList removes a single block list item.
 func (l *Lidarr) DeleteBlockList(listID int64) error {
 	return l.DeleteBlockListContext(context.Background(), listID)
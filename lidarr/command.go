@@ -14,13 +14,18 @@ import (
 const bpCommand = APIver + "/command"
 
 // CommandRequest goes into the /api/v1/command endpoint.
-// This was created from the search command and may not support other commands yet.
+// Create one with a command function, like RefreshArtistCommand, and pass it to SendCommand.
 type CommandRequest struct {
-	Name     string   `json:"name"`
-	AlbumIDs []int64  `json:"albumIds,omitempty"`
-	AlbumID  int64    `json:"albumId,omitempty"`
-	Folders  []string `json:"folders,omitempty"`
-	ArtistID int64    `json:"artistId,omitempty"`
+	Name             string   `json:"name"`
+	AlbumIDs         []int64  `json:"albumIds,omitempty"`
+	AlbumID          int64    `json:"albumId,omitempty"`
+	Folders          []string `json:"folders,omitempty"`
+	ArtistID         int64    `json:"artistId,omitempty"`
+	ArtistIDs        []int64  `json:"artistIds,omitempty"`
+	Files            []int64  `json:"files,omitempty"`            // RenameFiles and RetagFiles only
+	Path             string   `json:"path,omitempty"`             // DownloadedAlbumsScan only
+	DownloadClientID string   `json:"downloadClientId,omitempty"` // DownloadedAlbumsScan only
+	ImportMode       string   `json:"importMode,omitempty"`       // DownloadedAlbumsScan only
 }
 
 // ManualImportFile is one file in a ManualImport command request.
@@ -164,3 +169,34 @@ func (l *Lidarr) SendManualImportCommandContext(
 
 	return &output, nil
 }
+
+// CommandResult is returned by WaitForCommand and SendCommandAndWait.
+type CommandResult = starr.CommandResult[CommandResponse]
+
+// WaitForCommand polls a command, with backoff, until it completes, fails or is aborted.
+// A failed command is not an error; check the Status. If the context is cancelled,
+// the last known status is returned with the context error.
+func (l *Lidarr) WaitForCommand(ctx context.Context, commandID int64) (*CommandResult, error) {
+	return starr.WaitForCommand(ctx, func(ctx context.Context) (*CommandResponse, starr.CommandStatus, error) {
+		output, err := l.GetCommandStatusContext(ctx, commandID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, starr.CommandStatus(output.Status), nil
+	})
+}
+
+// SendCommandAndWait sends a command to Lidarr and waits for it to finish. See WaitForCommand.
+func (l *Lidarr) SendCommandAndWait(ctx context.Context, cmd *CommandRequest) (*CommandResult, error) {
+	output, err := l.SendCommandContext(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	if output.ID == 0 {
+		return &CommandResult{Command: output, Status: starr.CommandStatus(output.Status)}, nil
+	}
+
+	return l.WaitForCommand(ctx, output.ID)
+}
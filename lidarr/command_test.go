@@ -237,3 +237,24 @@ func TestSendManualImportCommandContext(t *testing.T) {
 		})
 	}
 }
+
+func TestCommandConstructors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		cmd      *lidarr.CommandRequest
+		expected string
+	}{
+		{lidarr.RefreshArtistCommand(5), `{"name":"RefreshArtist","artistId":5,"artistIds":[5]}`},
+		{lidarr.AlbumSearchCommand(1, 2), `{"name":"AlbumSearch","albumIds":[1,2]}`},
+		{lidarr.RescanFoldersCommand("/music"), `{"name":"RescanFolders","folders":["/music"]}`},
+		{lidarr.RetagFilesCommand(5, 9), `{"name":"RetagFiles","artistId":5,"files":[9]}`},
+		{lidarr.DownloadedAlbumsScanCommand("", "", ""), `{"name":"DownloadedAlbumsScan"}`},
+	}
+
+	for _, test := range tests {
+		output, err := json.Marshal(test.cmd)
+		require.NoError(t, err)
+		assert.JSONEq(t, test.expected, string(output), test.cmd.Name)
+	}
+}
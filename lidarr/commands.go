@@ -0,0 +1,134 @@
+package lidarr
+
+/* This file contains a constructor for each command Lidarr accepts on the /command endpoint.
+ * The names and bodies come from the *Command classes in the Lidarr source code.
+ * Pass the output of any of these to SendCommand or SendCommandAndWait.
+ */
+
+// Import modes for DownloadedAlbumsScanCommand.
+const (
+	ImportModeAuto = "auto"
+	ImportModeMove = "move"
+	ImportModeCopy = "copy"
+)
+
+// RefreshArtistCommand refreshes metadata for artists, and rescans their files. No IDs refreshes every artist.
+func RefreshArtistCommand(artistIDs ...int64) *CommandRequest {
+	cmd := &CommandRequest{Name: "RefreshArtist", ArtistIDs: artistIDs}
+	if len(artistIDs) == 1 {
+		cmd.ArtistID = artistIDs[0] // Older versions only accept one.
+	}
+
+	return cmd
+}
+
+// RefreshAlbumCommand refreshes metadata for an album.
+func RefreshAlbumCommand(albumID int64) *CommandRequest {
+	return &CommandRequest{Name: "RefreshAlbum", AlbumID: albumID}
+}
+
+// RescanFoldersCommand rescans root folders for new files. No folders rescans every root folder.
+func RescanFoldersCommand(folders ...string) *CommandRequest {
+	return &CommandRequest{Name: "RescanFolders", Folders: folders}
+}
+
+// ArtistSearchCommand searches for every missing monitored album by an artist.
+func ArtistSearchCommand(artistID int64) *CommandRequest {
+	return &CommandRequest{Name: "ArtistSearch", ArtistID: artistID}
+}
+
+// AlbumSearchCommand searches for one or more albums.
+func AlbumSearchCommand(albumIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "AlbumSearch", AlbumIDs: albumIDs}
+}
+
+// MissingAlbumSearchCommand searches for every missing monitored album.
+func MissingAlbumSearchCommand() *CommandRequest {
+	return &CommandRequest{Name: "MissingAlbumSearch"}
+}
+
+// CutoffUnmetAlbumSearchCommand searches for upgrades to every album that has not met its cutoff.
+func CutoffUnmetAlbumSearchCommand() *CommandRequest {
+	return &CommandRequest{Name: "CutoffUnmetAlbumSearch"}
+}
+
+// RenameFilesCommand renames track files for an artist. Get the file IDs from GetArtistRenames.
+func RenameFilesCommand(artistID int64, fileIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "RenameFiles", ArtistID: artistID, Files: fileIDs}
+}
+
+// RenameArtistCommand renames every track file for one or more artists.
+func RenameArtistCommand(artistIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "RenameArtist", ArtistIDs: artistIDs}
+}
+
+// RetagFilesCommand writes tags to track files for an artist.
+func RetagFilesCommand(artistID int64, fileIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "RetagFiles", ArtistID: artistID, Files: fileIDs}
+}
+
+// RetagArtistCommand writes tags to every track file for one or more artists.
+func RetagArtistCommand(artistIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "RetagArtist", ArtistIDs: artistIDs}
+}
+
+// DownloadedAlbumsScanCommand imports finished downloads from a path. All inputs are optional.
+// Without a path, every download client is checked. The import mode may be auto, move or copy.
+func DownloadedAlbumsScanCommand(path, downloadClientID, importMode string) *CommandRequest {
+	return &CommandRequest{
+		Name:             "DownloadedAlbumsScan",
+		Path:             path,
+		DownloadClientID: downloadClientID,
+		ImportMode:       importMode,
+	}
+}
+
+// RssSyncCommand checks every indexer's RSS feed for new releases.
+func RssSyncCommand() *CommandRequest {
+	return &CommandRequest{Name: "RssSync"}
+}
+
+// RefreshMonitoredDownloadsCommand checks download clients for finished downloads.
+func RefreshMonitoredDownloadsCommand() *CommandRequest {
+	return &CommandRequest{Name: "RefreshMonitoredDownloads"}
+}
+
+// ImportListSyncCommand syncs every import list.
+func ImportListSyncCommand() *CommandRequest {
+	return &CommandRequest{Name: "ImportListSync"}
+}
+
+// BackupCommand creates a manual backup. Find it with GetBackupFiles.
+func BackupCommand() *CommandRequest {
+	return &CommandRequest{Name: "Backup"}
+}
+
+// CheckHealthCommand runs every health check.
+func CheckHealthCommand() *CommandRequest {
+	return &CommandRequest{Name: "CheckHealth"}
+}
+
+// ApplicationCheckUpdateCommand checks for an application update.
+func ApplicationCheckUpdateCommand() *CommandRequest {
+	return &CommandRequest{Name: "ApplicationCheckUpdate"}
+}
+
+// ApplicationUpdateCommand installs an available application update.
+func ApplicationUpdateCommand() *CommandRequest {
+	return &CommandRequest{Name: "ApplicationUpdate"}
+}
+
+// ClearBlocklistCommand removes every item from the blocklist.
+func ClearBlocklistCommand() *CommandRequest {
+	return &CommandRequest{Name: "ClearBlocklist"}
+}
+
+// CleanUpRecycleBinCommand empties old files from the recycle bin.
+func CleanUpRecycleBinCommand() *CommandRequest {
+	return &CommandRequest{Name: "CleanUpRecycleBin"}
+}
+
+// HousekeepingCommand runs the housekeeping (database cleanup) tasks.
+func HousekeepingCommand() *CommandRequest {
+	return &CommandRequest{Name: "Housekeeping"}
+}
@@ -143,3 +143,51 @@ func (l *Lidarr) DeleteCustomFormatContext(ctx context.Context, cfID int64) erro
 
 	return nil
 }
+
+// UpdateCustomFormats bulk updates custom formats.
+func (l *Lidarr) UpdateCustomFormats(bulk *starr.BulkCustomFormat) ([]*CustomFormatOutput, error) {
+	return l.UpdateCustomFormatsContext(context.Background(), bulk)
+}
+
+// UpdateCustomFormatsContext bulk updates custom formats.
+func (l *Lidarr) UpdateCustomFormatsContext(
+	ctx context.Context, bulk *starr.BulkCustomFormat,
+) ([]*CustomFormatOutput, error) {
+	var (
+		output []*CustomFormatOutput
+		body   bytes.Buffer
+	)
+
+	if err := json.NewEncoder(&body).Encode(bulk); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpCustomFormat, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpCustomFormat, "bulk"), Body: &body}
+	if err := l.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteCustomFormats bulk deletes custom formats by their IDs.
+func (l *Lidarr) DeleteCustomFormats(ids []int64) error {
+	return l.DeleteCustomFormatsContext(context.Background(), ids)
+}
+
+// DeleteCustomFormatsContext bulk deletes custom formats by their IDs.
+func (l *Lidarr) DeleteCustomFormatsContext(ctx context.Context, ids []int64) error {
+	input := starr.BulkDelete{IDs: ids}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpCustomFormat, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpCustomFormat, "bulk"), Body: &body}
+	if err := l.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
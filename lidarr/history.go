@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"iter"
 	"path"
 	"time"
 
@@ -117,6 +118,19 @@ func (l *Lidarr) GetHistoryPageContext(ctx context.Context, params *starr.PageRe
 	return &output, nil
 }
 
+// HistoryAll returns an iterator over every History record (grabs/failures/completed) from Lidarr.
+// Pages are fetched lazily as the loop runs, and the loop may break early. See starr.Paginate for more.
+func (l *Lidarr) HistoryAll(ctx context.Context, params *starr.PageReq) iter.Seq2[*HistoryRecord, error] {
+	return starr.Paginate(ctx, params, func(ctx context.Context, req *starr.PageReq) ([]*HistoryRecord, int, error) {
+		page, err := l.GetHistoryPageContext(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Records, page.TotalRecords, nil
+	})
+}
+
 // Fail marks the given history item as failed by id.
 func (l *Lidarr) Fail(historyID int64) error {
 	return l.FailContext(context.Background(), historyID)
@@ -9,6 +9,7 @@ import (
 	"path"
 
 	"golift.io/starr"
+	"golift.io/starr/starrshared"
 )
 
 const bpImportList = APIver + "/importlist"
@@ -184,3 +185,115 @@ func (l *Lidarr) DeleteImportListContext(ctx context.Context, importListID int64
 
 	return nil
 }
+
+// UpdateImportLists bulk updates import lists.
+// Use it to enable, re-tag or move many lists at once.
+func (l *Lidarr) UpdateImportLists(bulk *starr.BulkImportList) ([]*ImportListOutput, error) {
+	return l.UpdateImportListsContext(context.Background(), bulk)
+}
+
+// UpdateImportListsContext bulk updates import lists.
+// Use it to enable, re-tag or move many lists at once.
+func (l *Lidarr) UpdateImportListsContext(
+	ctx context.Context, bulk *starr.BulkImportList,
+) ([]*ImportListOutput, error) {
+	var (
+		output []*ImportListOutput
+		body   bytes.Buffer
+	)
+
+	if err := json.NewEncoder(&body).Encode(bulk); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpImportList, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpImportList, "bulk"), Body: &body}
+	if err := l.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteImportLists bulk deletes import lists by their IDs.
+func (l *Lidarr) DeleteImportLists(ids []int64) error {
+	return l.DeleteImportListsContext(context.Background(), ids)
+}
+
+// DeleteImportListsContext bulk deletes import lists by their IDs.
+func (l *Lidarr) DeleteImportListsContext(ctx context.Context, ids []int64) error {
+	input := starr.BulkDelete{IDs: ids}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpImportList, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpImportList, "bulk"), Body: &body}
+	if err := l.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// GetImportListSchema returns a template for every import list implementation the app has.
+func (l *Lidarr) GetImportListSchema() ([]*ImportListOutput, error) {
+	return l.GetImportListSchemaContext(context.Background())
+}
+
+// GetImportListSchemaContext returns a template for every import list implementation the app has.
+func (l *Lidarr) GetImportListSchemaContext(ctx context.Context) ([]*ImportListOutput, error) {
+	var output []*ImportListOutput
+
+	req := starr.Request{URI: path.Join(bpImportList, "schema")}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// TestAllImportLists tests every import list.
+// Validation failures are returned in the results, not as an error.
+func (l *Lidarr) TestAllImportLists() ([]*ProviderTestResult, error) {
+	return l.TestAllImportListsContext(context.Background())
+}
+
+// TestAllImportListsContext tests every import list.
+// Validation failures are returned in the results, not as an error.
+func (l *Lidarr) TestAllImportListsContext(ctx context.Context) ([]*ProviderTestResult, error) {
+	var output []*ProviderTestResult
+
+	req := starr.Request{URI: path.Join(bpImportList, "testall")}
+	if err := l.PostInto(ctx, req, &output); err != nil && !starrshared.TestResultsFromError(err, &output) {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// ImportListAction runs a named action on an import list, like the ones its settings form uses
+// to fill in options. The reply depends on the action, so it is returned as raw JSON.
+func (l *Lidarr) ImportListAction(name string, list *ImportListInput) (json.RawMessage, error) {
+	return l.ImportListActionContext(context.Background(), name, list)
+}
+
+// ImportListActionContext runs a named action on an import list, like the ones its settings form uses
+// to fill in options. The reply depends on the action, so it is returned as raw JSON.
+func (l *Lidarr) ImportListActionContext(
+	ctx context.Context, name string, list *ImportListInput,
+) (json.RawMessage, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(list); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpImportList, err)
+	}
+
+	var output json.RawMessage
+
+	req := starr.Request{URI: path.Join(bpImportList, "action", path.Base(name)), Body: &body}
+	if err := l.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
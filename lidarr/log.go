@@ -0,0 +1,117 @@
+package lidarr
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"path"
+
+	"golift.io/starr"
+	"golift.io/starr/starrshared"
+)
+
+const bpLog = APIver + "/log"
+
+// LogLine is one record from /api/v1/log.
+type LogLine = starrshared.LogLine
+
+// LogPage is a page of log lines from /api/v1/log.
+type LogPage = starrshared.LogPage
+
+// LogFile describes a log file on disk.
+type LogFile = starrshared.LogFile
+
+// LogTailOptions filter and pace the log lines from TailLogs.
+type LogTailOptions = starrshared.TailOptions
+
+// GetLogPage returns a page of application log lines.
+func (l *Lidarr) GetLogPage(params *starr.PageReq) (*LogPage, error) {
+	return l.GetLogPageContext(context.Background(), params)
+}
+
+// GetLogPageContext returns a page of application log lines.
+func (l *Lidarr) GetLogPageContext(ctx context.Context, params *starr.PageReq) (*LogPage, error) {
+	var output LogPage
+
+	req := starr.Request{URI: bpLog, Query: params.Params()}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetLogFiles returns the list of log files.
+func (l *Lidarr) GetLogFiles() ([]*LogFile, error) {
+	return l.GetLogFilesContext(context.Background())
+}
+
+// GetLogFilesContext returns the list of log files.
+func (l *Lidarr) GetLogFilesContext(ctx context.Context) ([]*LogFile, error) {
+	var output []*LogFile
+
+	req := starr.Request{URI: path.Join(bpLog, "file")}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetLogFile returns the contents of a named log file.
+func (l *Lidarr) GetLogFile(filename string) (*LogFile, error) {
+	return l.GetLogFileContext(context.Background(), filename)
+}
+
+// GetLogFileContext returns the contents of a named log file.
+func (l *Lidarr) GetLogFileContext(ctx context.Context, filename string) (*LogFile, error) {
+	var output LogFile
+
+	req := starr.Request{URI: path.Join(bpLog, "file", filename)}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateLogFiles triggers a log file update/roll.
+func (l *Lidarr) UpdateLogFiles() ([]*LogFile, error) {
+	return l.UpdateLogFilesContext(context.Background())
+}
+
+// UpdateLogFilesContext triggers a log file update/roll.
+func (l *Lidarr) UpdateLogFilesContext(ctx context.Context) ([]*LogFile, error) {
+	var output []*LogFile
+
+	req := starr.Request{URI: path.Join(bpLog, "file", "update")}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// UpdateLogFile triggers update for a specific log file.
+func (l *Lidarr) UpdateLogFile(filename string) ([]*LogFile, error) {
+	return l.UpdateLogFileContext(context.Background(), filename)
+}
+
+// UpdateLogFileContext triggers update for a specific log file.
+func (l *Lidarr) UpdateLogFileContext(ctx context.Context, filename string) ([]*LogFile, error) {
+	var output []*LogFile
+
+	req := starr.Request{URI: path.Join(bpLog, "file", "update", filename)}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// TailLogs returns an iterator that polls the log and yields new lines, oldest first, as they are logged.
+// It runs until the context is cancelled or the loop breaks. Filter lines by level and logger in the options.
+// A failed poll is yielded with a nil line, and ends the loop. See starrshared.TailLogs for more.
+func (l *Lidarr) TailLogs(ctx context.Context, opts *LogTailOptions) iter.Seq2[*LogLine, error] {
+	return starrshared.TailLogs(ctx, l.GetLogPageContext, opts)
+}
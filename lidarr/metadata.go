@@ -0,0 +1,245 @@
+package lidarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+
+	"golift.io/starr"
+	"golift.io/starr/starrshared"
+)
+
+const bpMetadata = APIver + "/metadata"
+
+// MetadataProviderMessage is the provider message object on metadata consumers.
+type MetadataProviderMessage struct {
+	Message string `json:"message,omitempty"`
+	Type    string `json:"type,omitempty"`
+}
+
+// MetadataOutput is the output from /api/v1/metadata (MetadataResource).
+type MetadataOutput struct {
+	ID                 int64                    `json:"id,omitempty"`
+	Name               string                   `json:"name,omitempty"`
+	Fields             []*starr.FieldOutput     `json:"fields,omitempty"`
+	ImplementationName string                   `json:"implementationName,omitempty"`
+	Implementation     string                   `json:"implementation,omitempty"`
+	ConfigContract     string                   `json:"configContract,omitempty"`
+	InfoLink           string                   `json:"infoLink,omitempty"`
+	Message            *MetadataProviderMessage `json:"message,omitempty"`
+	Tags               []int                    `json:"tags,omitempty"`
+	Presets            []*MetadataOutput        `json:"presets,omitempty"`
+	Enable             bool                     `json:"enable"`
+}
+
+// MetadataInput is the input for creating or updating metadata consumers.
+type MetadataInput struct {
+	ID             int64               `json:"id,omitempty"`
+	Name           string              `json:"name,omitempty"`
+	Fields         []*starr.FieldInput `json:"fields,omitempty"`
+	Implementation string              `json:"implementation,omitempty"`
+	ConfigContract string              `json:"configContract,omitempty"`
+	Tags           []int               `json:"tags,omitempty"`
+	Enable         bool                `json:"enable"`
+}
+
+// GetMetadata returns all configured metadata consumers.
+func (l *Lidarr) GetMetadata() ([]*MetadataOutput, error) {
+	return l.GetMetadataContext(context.Background())
+}
+
+// GetMetadataContext returns all configured metadata consumers.
+func (l *Lidarr) GetMetadataContext(ctx context.Context) ([]*MetadataOutput, error) {
+	var output []*MetadataOutput
+
+	req := starr.Request{URI: bpMetadata}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetMetadataByID returns a single metadata consumer.
+func (l *Lidarr) GetMetadataByID(id int64) (*MetadataOutput, error) {
+	return l.GetMetadataByIDContext(context.Background(), id)
+}
+
+// GetMetadataByIDContext returns a single metadata consumer.
+func (l *Lidarr) GetMetadataByIDContext(ctx context.Context, id int64) (*MetadataOutput, error) {
+	var output MetadataOutput
+
+	req := starr.Request{URI: path.Join(bpMetadata, starr.Str(id))}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetMetadataSchema returns metadata consumer templates.
+func (l *Lidarr) GetMetadataSchema() ([]*MetadataOutput, error) {
+	return l.GetMetadataSchemaContext(context.Background())
+}
+
+// GetMetadataSchemaContext returns metadata consumer templates.
+func (l *Lidarr) GetMetadataSchemaContext(ctx context.Context) ([]*MetadataOutput, error) {
+	var output []*MetadataOutput
+
+	req := starr.Request{URI: path.Join(bpMetadata, "schema")}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// AddMetadata creates a metadata consumer.
+func (l *Lidarr) AddMetadata(input *MetadataInput, forceSave bool) (*MetadataOutput, error) {
+	return l.AddMetadataContext(context.Background(), input, forceSave)
+}
+
+// AddMetadataContext creates a metadata consumer.
+func (l *Lidarr) AddMetadataContext(
+	ctx context.Context, input *MetadataInput, forceSave bool,
+) (*MetadataOutput, error) {
+	var output MetadataOutput
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpMetadata, err)
+	}
+
+	q := url.Values{}
+	if forceSave {
+		q.Set("forceSave", "true")
+	}
+
+	req := starr.Request{URI: bpMetadata, Body: &body, Query: q}
+	if err := l.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateMetadata updates a metadata consumer.
+func (l *Lidarr) UpdateMetadata(input *MetadataInput, forceSave bool) (*MetadataOutput, error) {
+	return l.UpdateMetadataContext(context.Background(), input, forceSave)
+}
+
+// UpdateMetadataContext updates a metadata consumer.
+func (l *Lidarr) UpdateMetadataContext(
+	ctx context.Context, input *MetadataInput, forceSave bool,
+) (*MetadataOutput, error) {
+	var output MetadataOutput
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpMetadata, err)
+	}
+
+	params := url.Values{}
+	if forceSave {
+		params.Set("forceSave", "true")
+	}
+
+	uri := path.Join(bpMetadata, starr.Str(input.ID))
+
+	req := starr.Request{URI: uri, Body: &body, Query: params}
+	if err := l.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// DeleteMetadata deletes a metadata consumer.
+func (l *Lidarr) DeleteMetadata(id int64) error {
+	return l.DeleteMetadataContext(context.Background(), id)
+}
+
+// DeleteMetadataContext deletes a metadata consumer.
+func (l *Lidarr) DeleteMetadataContext(ctx context.Context, id int64) error {
+	req := starr.Request{URI: path.Join(bpMetadata, starr.Str(id))}
+	if err := l.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// MetadataActionResult runs a named action on a metadata consumer, like the ones its settings form uses
+// to fill in options. The reply depends on the action, so it is returned as raw JSON.
+func (l *Lidarr) MetadataActionResult(name string, input *MetadataInput) (json.RawMessage, error) {
+	return l.MetadataActionResultContext(context.Background(), name, input)
+}
+
+// MetadataActionResultContext runs a named action on a metadata consumer, like the ones its settings form
+// uses to fill in options. The reply depends on the action, so it is returned as raw JSON.
+func (l *Lidarr) MetadataActionResultContext(
+	ctx context.Context, name string, input *MetadataInput,
+) (json.RawMessage, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpMetadata, err)
+	}
+
+	var output json.RawMessage
+
+	req := starr.Request{URI: path.Join(bpMetadata, "action", path.Base(name)), Body: &body}
+	if err := l.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// TestMetadata tests a metadata consumer configuration.
+func (l *Lidarr) TestMetadata(input *MetadataInput, forceTest bool) error {
+	return l.TestMetadataContext(context.Background(), input, forceTest)
+}
+
+// TestMetadataContext tests a metadata consumer configuration.
+func (l *Lidarr) TestMetadataContext(ctx context.Context, input *MetadataInput, forceTest bool) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpMetadata, err)
+	}
+
+	query := url.Values{}
+	if forceTest {
+		query.Set("forceTest", "true")
+	}
+
+	var output any
+
+	req := starr.Request{URI: path.Join(bpMetadata, "test"), Body: &body, Query: query}
+	if err := l.PostInto(ctx, req, &output); err != nil {
+		return fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// TestAllMetadataResults tests all metadata consumers.
+// Validation failures are returned in the results, not as an error.
+func (l *Lidarr) TestAllMetadataResults() ([]*ProviderTestResult, error) {
+	return l.TestAllMetadataResultsContext(context.Background())
+}
+
+// TestAllMetadataResultsContext tests all metadata consumers.
+// Validation failures are returned in the results, not as an error.
+func (l *Lidarr) TestAllMetadataResultsContext(ctx context.Context) ([]*ProviderTestResult, error) {
+	var output []*ProviderTestResult
+
+	req := starr.Request{URI: path.Join(bpMetadata, "testall")}
+	if err := l.PostInto(ctx, req, &output); err != nil && !starrshared.TestResultsFromError(err, &output) {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
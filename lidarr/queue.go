@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"path"
 	"time"
 
@@ -109,6 +110,19 @@ func (l *Lidarr) GetQueuePageContext(ctx context.Context, params *starr.PageReq)
 	return &output, nil
 }
 
+// QueueAll returns an iterator over every Queue record (processing, but not yet imported) from Lidarr.
+// Pages are fetched lazily as the loop runs, and the loop may break early. See starr.Paginate for more.
+func (l *Lidarr) QueueAll(ctx context.Context, params *starr.PageReq) iter.Seq2[*QueueRecord, error] {
+	return starr.Paginate(ctx, params, func(ctx context.Context, req *starr.PageReq) ([]*QueueRecord, int, error) {
+		page, err := l.GetQueuePageContext(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Records, page.TotalRecords, nil
+	})
+}
+
 // DeleteQueue deletes an item from the Activity Queue.
 func (l *Lidarr) DeleteQueue(queueID int64, opts *starr.QueueDeleteOpts) error {
 	return l.DeleteQueueContext(context.Background(), queueID, opts)
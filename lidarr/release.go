@@ -0,0 +1,164 @@
+package lidarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"time"
+
+	"golift.io/starr"
+	"golift.io/starr/starrshared"
+)
+
+const bpRelease = APIver + "/release"
+
+// ReleasePush is the input for PushRelease.
+type ReleasePush = starrshared.ReleasePush
+
+// ReleaseOutput is the output from the Lidarr release endpoint.
+// Rejections lists the reasons Lidarr would not grab it on its own; CustomFormatScore is its score
+// in the artist's quality profile.
+type ReleaseOutput struct {
+	ID                  int64                 `json:"id"`
+	GUID                string                `json:"guid"`
+	Quality             *starr.Quality        `json:"quality"`
+	QualityWeight       int64                 `json:"qualityWeight"`
+	Age                 int64                 `json:"age"`
+	AgeHours            float64               `json:"ageHours"`
+	AgeMinutes          float64               `json:"ageMinutes"`
+	Size                int64                 `json:"size"`
+	IndexerID           int64                 `json:"indexerId"`
+	Indexer             string                `json:"indexer"`
+	ReleaseGroup        string                `json:"releaseGroup"`
+	SubGroup            string                `json:"subGroup"`
+	ReleaseHash         string                `json:"releaseHash"`
+	Title               string                `json:"title"`
+	Discography         bool                  `json:"discography"`
+	SceneSource         bool                  `json:"sceneSource"`
+	AirDate             string                `json:"airDate"`
+	ArtistName          string                `json:"artistName"`
+	AlbumTitle          string                `json:"albumTitle"`
+	Approved            bool                  `json:"approved"`
+	TemporarilyRejected bool                  `json:"temporarilyRejected"`
+	Rejected            bool                  `json:"rejected"`
+	Rejections          []string              `json:"rejections"`
+	PublishDate         time.Time             `json:"publishDate"`
+	CommentURL          string                `json:"commentUrl"`
+	DownloadURL         string                `json:"downloadUrl"`
+	InfoURL             string                `json:"infoUrl"`
+	DownloadAllowed     bool                  `json:"downloadAllowed"`
+	ReleaseWeight       int64                 `json:"releaseWeight"`
+	CustomFormats       []*CustomFormatOutput `json:"customFormats"`
+	CustomFormatScore   int64                 `json:"customFormatScore"`
+	MagnetURL           string                `json:"magnetUrl"`
+	InfoHash            string                `json:"infoHash"`
+	Seeders             int                   `json:"seeders"`
+	Leechers            int                   `json:"leechers"`
+	Protocol            starr.Protocol        `json:"protocol"`
+	IndexerFlags        int64                 `json:"indexerFlags,omitempty"`
+	ArtistID            int64                 `json:"artistId"`
+	AlbumID             int64                 `json:"albumId"`
+	DownloadClientID    int64                 `json:"downloadClientId"`
+	DownloadClient      string                `json:"downloadClient"`
+}
+
+// SearchRelease is the input needed to search for releases through Lidarr.
+// Set AlbumID to search for one album, or ArtistID to search for every album by an artist.
+type SearchRelease struct {
+	AlbumID  int64 `json:"albumId,omitempty"`
+	ArtistID int64 `json:"artistId,omitempty"`
+}
+
+// SearchRelease searches the indexers for an album or artist, and returns the releases available for download.
+func (l *Lidarr) SearchRelease(input *SearchRelease) ([]*ReleaseOutput, error) {
+	return l.SearchReleaseContext(context.Background(), input)
+}
+
+// SearchReleaseContext searches the indexers for an album or artist, and returns the releases available for download.
+func (l *Lidarr) SearchReleaseContext(ctx context.Context, input *SearchRelease) ([]*ReleaseOutput, error) {
+	req := starr.Request{URI: bpRelease, Query: make(url.Values)}
+
+	if input.AlbumID != 0 {
+		req.Query.Set("albumId", starr.Str(input.AlbumID))
+	}
+
+	if input.ArtistID != 0 {
+		req.Query.Set("artistId", starr.Str(input.ArtistID))
+	}
+
+	var output []*ReleaseOutput
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// Grab adds a release and attempts to download it. Use this with Pr*wlarr search output.
+func (l *Lidarr) Grab(guid string, indexerID int64) (*ReleaseOutput, error) {
+	return l.GrabContext(context.Background(), guid, indexerID)
+}
+
+// GrabContext adds a release and attempts to download it. Use this with Pr*wlarr search output.
+func (l *Lidarr) GrabContext(ctx context.Context, guid string, indexerID int64) (*ReleaseOutput, error) {
+	return l.GrabReleaseContext(ctx, &ReleaseOutput{IndexerID: indexerID, GUID: guid})
+}
+
+// GrabRelease adds a release and attempts to download it.
+// Pass the release for the item from the SearchRelease output.
+func (l *Lidarr) GrabRelease(release *ReleaseOutput) (*ReleaseOutput, error) {
+	return l.GrabReleaseContext(context.Background(), release)
+}
+
+// GrabReleaseContext adds a release and attempts to download it.
+// Pass the release for the item from the SearchRelease output.
+// Lidarr only grabs releases from a recent search, so search first.
+func (l *Lidarr) GrabReleaseContext(ctx context.Context, release *ReleaseOutput) (*ReleaseOutput, error) {
+	grab := struct { // We only use/need the guid and indexerID from the release.
+		G string `json:"guid"`
+		I int64  `json:"indexerId"`
+	}{G: release.GUID, I: release.IndexerID}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(&grab); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpRelease, err)
+	}
+
+	var output ReleaseOutput
+
+	req := starr.Request{URI: bpRelease, Body: &body}
+	if err := l.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// PushRelease sends a release found elsewhere to Lidarr. Lidarr evaluates it like an RSS release,
+// and grabs it if it's wanted. The output has the decision, including any rejections.
+func (l *Lidarr) PushRelease(push *ReleasePush) (*ReleaseOutput, error) {
+	return l.PushReleaseContext(context.Background(), push)
+}
+
+// PushReleaseContext sends a release found elsewhere to Lidarr. Lidarr evaluates it like an RSS release,
+// and grabs it if it's wanted. The output has the decision, including any rejections.
+func (l *Lidarr) PushReleaseContext(ctx context.Context, push *ReleasePush) (*ReleaseOutput, error) {
+	uri := path.Join(bpRelease, "push")
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(push); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", uri, err)
+	}
+
+	var output ReleaseOutput
+
+	req := starr.Request{URI: uri, Body: &body}
+	if err := l.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
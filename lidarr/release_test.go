@@ -0,0 +1,108 @@
+package lidarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/lidarr"
+	"golift.io/starr/starrtest"
+)
+
+const releaseBody = `{"guid": "abc", "indexerId": 3, "title": "Artist - Album (2024) [FLAC]", "approved": false,
+"rejections": ["Not an upgrade"], "customFormatScore": 150, "albumId": 12, "artistId": 4}`
+
+func TestSearchRelease(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "release?albumId=12"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			WithRequest:    &lidarr.SearchRelease{AlbumID: 12},
+			ResponseBody:   "[" + releaseBody + "]",
+			WithResponse: []*lidarr.ReleaseOutput{{
+				GUID:              "abc",
+				IndexerID:         3,
+				Title:             "Artist - Album (2024) [FLAC]",
+				Rejections:        []string{"Not an upgrade"},
+				CustomFormatScore: 150,
+				AlbumID:           12,
+				ArtistID:          4,
+			}},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "release?artistId=4"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			WithRequest:    &lidarr.SearchRelease{ArtistID: 4},
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   []*lidarr.ReleaseOutput(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.SearchRelease(test.WithRequest.(*lidarr.SearchRelease))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGrabRelease(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, lidarr.APIver, "release"),
+			ExpectedMethod:  "POST",
+			ResponseStatus:  200,
+			WithRequest:     &lidarr.ReleaseOutput{GUID: "abc", IndexerID: 3, Title: "ignored"},
+			ExpectedRequest: `{"guid":"abc","indexerId":3}` + "\n",
+			ResponseBody:    releaseBody,
+			WithResponse: &lidarr.ReleaseOutput{
+				GUID:              "abc",
+				IndexerID:         3,
+				Title:             "Artist - Album (2024) [FLAC]",
+				Rejections:        []string{"Not an upgrade"},
+				CustomFormatScore: 150,
+				AlbumID:           12,
+				ArtistID:          4,
+			},
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, lidarr.APIver, "release"),
+			ExpectedMethod:  "POST",
+			ResponseStatus:  404,
+			WithRequest:     &lidarr.ReleaseOutput{GUID: "abc", IndexerID: 3},
+			ExpectedRequest: `{"guid":"abc","indexerId":3}` + "\n",
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:    (*lidarr.ReleaseOutput)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GrabRelease(test.WithRequest.(*lidarr.ReleaseOutput))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
@@ -1,21 +1,32 @@
 package lidarr
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"path"
 
 	"golift.io/starr"
 )
 
 const bpRootFolder = APIver + "/rootFolder"
 
-// RootFolder is the /api/v1/rootfolder endpoint.
+// RootFolder is the /api/v1/rootfolder endpoint. Name, Path and both default profiles are required to add one.
+// The defaults are used for artists added from this folder by import lists and the import process.
 type RootFolder struct {
-	ID              int64         `json:"id"`
-	Path            string        `json:"path"`
-	FreeSpace       int64         `json:"freeSpace"`
-	TotalSpace      int64         `json:"totalSpace"`
-	UnmappedFolders []*starr.Path `json:"unmappedFolders"`
+	ID                          int64         `json:"id"`
+	Name                        string        `json:"name"`
+	Path                        string        `json:"path"`
+	DefaultMetadataProfileID    int64         `json:"defaultMetadataProfileId"`
+	DefaultQualityProfileID     int64         `json:"defaultQualityProfileId"`
+	DefaultMonitorOption        string        `json:"defaultMonitorOption,omitempty"`        // all, future, missing, etc.
+	DefaultNewItemMonitorOption string        `json:"defaultNewItemMonitorOption,omitempty"` // all, none or new.
+	DefaultTags                 []int         `json:"defaultTags"`
+	Accessible                  bool          `json:"accessible,omitempty"`
+	FreeSpace                   int64         `json:"freeSpace,omitempty"`
+	TotalSpace                  int64         `json:"totalSpace,omitempty"`
+	UnmappedFolders             []*starr.Path `json:"unmappedFolders,omitempty"`
 }
 
 // GetRootFolders returns all configured root folders.
@@ -34,3 +45,79 @@ func (l *Lidarr) GetRootFoldersContext(ctx context.Context) ([]*RootFolder, erro
 
 	return output, nil
 }
+
+// GetRootFolder returns a single root folder.
+func (l *Lidarr) GetRootFolder(folderID int64) (*RootFolder, error) {
+	return l.GetRootFolderContext(context.Background(), folderID)
+}
+
+// GetRootFolderContext returns a single root folder.
+func (l *Lidarr) GetRootFolderContext(ctx context.Context, folderID int64) (*RootFolder, error) {
+	var output RootFolder
+
+	req := starr.Request{URI: path.Join(bpRootFolder, starr.Str(folderID))}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// AddRootFolder creates a root folder.
+func (l *Lidarr) AddRootFolder(folder *RootFolder) (*RootFolder, error) {
+	return l.AddRootFolderContext(context.Background(), folder)
+}
+
+// AddRootFolderContext creates a root folder.
+func (l *Lidarr) AddRootFolderContext(ctx context.Context, folder *RootFolder) (*RootFolder, error) {
+	var output RootFolder
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(folder); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpRootFolder, err)
+	}
+
+	req := starr.Request{URI: bpRootFolder, Body: &body}
+	if err := l.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateRootFolder updates a root folder. The path cannot be changed.
+func (l *Lidarr) UpdateRootFolder(folder *RootFolder) (*RootFolder, error) {
+	return l.UpdateRootFolderContext(context.Background(), folder)
+}
+
+// UpdateRootFolderContext updates a root folder. The path cannot be changed.
+func (l *Lidarr) UpdateRootFolderContext(ctx context.Context, folder *RootFolder) (*RootFolder, error) {
+	var output RootFolder
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(folder); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpRootFolder, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpRootFolder, starr.Str(folder.ID)), Body: &body}
+	if err := l.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// DeleteRootFolder removes a single root folder.
+func (l *Lidarr) DeleteRootFolder(folderID int64) error {
+	return l.DeleteRootFolderContext(context.Background(), folderID)
+}
+
+// DeleteRootFolderContext removes a single root folder.
+func (l *Lidarr) DeleteRootFolderContext(ctx context.Context, folderID int64) error {
+	req := starr.Request{URI: path.Join(bpRootFolder, starr.Str(folderID))}
+	if err := l.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
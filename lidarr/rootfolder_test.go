@@ -0,0 +1,242 @@
+package lidarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/lidarr"
+	"golift.io/starr/starrtest"
+)
+
+const rootFolderBody = `{"id": 2, "name": "Music", "path": "/music", "defaultMetadataProfileId": 1,
+"defaultQualityProfileId": 3, "defaultMonitorOption": "all", "defaultNewItemMonitorOption": "new",
+"defaultTags": [4], "accessible": true, "freeSpace": 1000, "totalSpace": 2000,
+"unmappedFolders": [{"name": "Loose", "path": "/music/Loose"}]}`
+
+//nolint:gochecknoglobals // test data.
+var rootFolderOutput = &lidarr.RootFolder{
+	ID:                          2,
+	Name:                        "Music",
+	Path:                        "/music",
+	DefaultMetadataProfileID:    1,
+	DefaultQualityProfileID:     3,
+	DefaultMonitorOption:        "all",
+	DefaultNewItemMonitorOption: "new",
+	DefaultTags:                 []int{4},
+	Accessible:                  true,
+	FreeSpace:                   1000,
+	TotalSpace:                  2000,
+	UnmappedFolders:             []*starr.Path{{Name: "Loose", Path: "/music/Loose"}},
+}
+
+func TestGetRootFolders(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "rootFolder"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			ResponseBody:   "[" + rootFolderBody + "]",
+			WithResponse:   []*lidarr.RootFolder{rootFolderOutput},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "rootFolder"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   []*lidarr.RootFolder(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetRootFolders()
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGetRootFolder(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "rootFolder", "2"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			WithRequest:    int64(2),
+			ResponseBody:   rootFolderBody,
+			WithResponse:   rootFolderOutput,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "rootFolder", "2"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			WithRequest:    int64(2),
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*lidarr.RootFolder)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetRootFolder(test.WithRequest.(int64))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestAddRootFolder(t *testing.T) {
+	t.Parallel()
+
+	folder := &lidarr.RootFolder{
+		Name:                     "Music",
+		Path:                     "/music",
+		DefaultMetadataProfileID: 1,
+		DefaultQualityProfileID:  3,
+		DefaultMonitorOption:     "all",
+		DefaultTags:              []int{4},
+	}
+	request := `{"id":0,"name":"Music","path":"/music","defaultMetadataProfileId":1,` +
+		`"defaultQualityProfileId":3,"defaultMonitorOption":"all","defaultTags":[4]}` + "\n"
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, lidarr.APIver, "rootFolder"),
+			ExpectedMethod:  "POST",
+			ResponseStatus:  200,
+			WithRequest:     folder,
+			ExpectedRequest: request,
+			ResponseBody:    rootFolderBody,
+			WithResponse:    rootFolderOutput,
+		},
+		{
+			Name:            "400",
+			ExpectedPath:    path.Join("/", starr.API, lidarr.APIver, "rootFolder"),
+			ExpectedMethod:  "POST",
+			ResponseStatus:  400,
+			WithRequest:     folder,
+			ExpectedRequest: request,
+			ResponseBody:    `{"message": "Invalid Path"}`,
+			WithError:       &starr.ReqError{Code: http.StatusBadRequest},
+			WithResponse:    (*lidarr.RootFolder)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.AddRootFolder(test.WithRequest.(*lidarr.RootFolder))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestUpdateRootFolder(t *testing.T) {
+	t.Parallel()
+
+	folder := &lidarr.RootFolder{
+		ID:                          2,
+		Name:                        "Music",
+		Path:                        "/music",
+		DefaultMetadataProfileID:    1,
+		DefaultQualityProfileID:     3,
+		DefaultMonitorOption:        "all",
+		DefaultNewItemMonitorOption: "new",
+		DefaultTags:                 []int{4},
+	}
+	request := `{"id":2,"name":"Music","path":"/music","defaultMetadataProfileId":1,"defaultQualityProfileId":3,` +
+		`"defaultMonitorOption":"all","defaultNewItemMonitorOption":"new","defaultTags":[4]}` + "\n"
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, lidarr.APIver, "rootFolder", "2"),
+			ExpectedMethod:  "PUT",
+			ResponseStatus:  200,
+			WithRequest:     folder,
+			ExpectedRequest: request,
+			ResponseBody:    rootFolderBody,
+			WithResponse:    rootFolderOutput,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, lidarr.APIver, "rootFolder", "2"),
+			ExpectedMethod:  "PUT",
+			ResponseStatus:  404,
+			WithRequest:     folder,
+			ExpectedRequest: request,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:    (*lidarr.RootFolder)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.UpdateRootFolder(test.WithRequest.(*lidarr.RootFolder))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestDeleteRootFolder(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "rootFolder", "2"),
+			ExpectedMethod: "DELETE",
+			ResponseStatus: 200,
+			WithRequest:    int64(2),
+			ResponseBody:   "{}",
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "rootFolder", "2"),
+			ExpectedMethod: "DELETE",
+			ResponseStatus: 404,
+			WithRequest:    int64(2),
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.DeleteRootFolder(test.WithRequest.(int64))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+		})
+	}
+}
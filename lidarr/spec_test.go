@@ -0,0 +1,12 @@
+package lidarr_test
+
+import (
+	"testing"
+
+	"golift.io/starr/starrtest"
+)
+
+func TestSpecDrift(t *testing.T) {
+	t.Parallel()
+	starrtest.ReportSpecDrift(t, "lidarr", nil)
+}
@@ -3,6 +3,7 @@ package lidarr
 import (
 	"context"
 	"fmt"
+	"iter"
 	"path"
 
 	"golift.io/starr"
@@ -48,6 +49,19 @@ func (l *Lidarr) GetWantedMissingPageContext(ctx context.Context, params *starr.
 	return &output, nil
 }
 
+// WantedMissingAll returns an iterator over every missing album from Lidarr.
+// Pages are fetched lazily as the loop runs, and the loop may break early. See starr.Paginate for more.
+func (l *Lidarr) WantedMissingAll(ctx context.Context, params *starr.PageReq) iter.Seq2[*Album, error] {
+	return starr.Paginate(ctx, params, func(ctx context.Context, req *starr.PageReq) ([]*Album, int, error) {
+		page, err := l.GetWantedMissingPageContext(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Records, page.TotalRecords, nil
+	})
+}
+
 // GetWantedCutoffPage returns a page of albums past quality cutoff.
 func (l *Lidarr) GetWantedCutoffPage(params *starr.PageReq) (*WantedAlbumsPage, error) {
 	return l.GetWantedCutoffPageContext(context.Background(), params)
@@ -67,3 +81,16 @@ func (l *Lidarr) GetWantedCutoffPageContext(ctx context.Context, params *starr.P
 
 	return &output, nil
 }
+
+// WantedCutoffAll returns an iterator over every album past quality cutoff from Lidarr.
+// Pages are fetched lazily as the loop runs, and the loop may break early. See starr.Paginate for more.
+func (l *Lidarr) WantedCutoffAll(ctx context.Context, params *starr.PageReq) iter.Seq2[*Album, error] {
+	return starr.Paginate(ctx, params, func(ctx context.Context, req *starr.PageReq) ([]*Album, int, error) {
+		page, err := l.GetWantedCutoffPageContext(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Records, page.TotalRecords, nil
+	})
+}
@@ -0,0 +1,103 @@
+package starr
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+/* This file contains the optional rate limiter and concurrency cap used by Config.req. */
+
+// Limiter is a token-bucket rate limiter combined with a cap on requests in flight.
+// Add one to a starr.Config to throttle every client (sonarr.New, radarr.New, etc.)
+// created from that config. A Limiter may also be shared by several configs.
+// Create one with NewLimiter; the zero value does not limit anything.
+type Limiter struct {
+	mu     sync.Mutex
+	rate   float64       // tokens per second.
+	burst  float64       // bucket size.
+	tokens float64       // tokens currently in the bucket.
+	last   time.Time     // last time tokens were added.
+	slots  chan struct{} // semaphore for requests in flight.
+}
+
+// NewLimiter returns a limiter that allows perSecond requests per second, with bursts up to burst,
+// and no more than maxInFlight requests at once. Use 0 for perSecond or maxInFlight to disable that limit.
+// A request stays "in flight" until its response body is closed.
+func NewLimiter(perSecond float64, burst, maxInFlight int) *Limiter {
+	limiter := &Limiter{rate: perSecond, burst: float64(max(burst, 1)), last: time.Now()}
+	limiter.tokens = limiter.burst
+
+	if maxInFlight > 0 {
+		limiter.slots = make(chan struct{}, maxInFlight)
+	}
+
+	return limiter
+}
+
+// Wait blocks until a request may be sent, or the context is cancelled.
+// The returned function must be called when the request is finished.
+func (l *Limiter) Wait(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	release := func() {}
+
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+			release = sync.OnceFunc(func() { <-l.slots })
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for request slot: %w", ctx.Err())
+		}
+	}
+
+	for {
+		wait := l.reserve()
+		if wait == 0 {
+			return release, nil
+		}
+
+		if err := sleepCtx(ctx, wait); err != nil {
+			release()
+			return nil, fmt.Errorf("waiting for rate limit: %w", err)
+		}
+	}
+}
+
+// reserve takes a token from the bucket and returns 0, or returns how long until one is available.
+func (l *Limiter) reserve() time.Duration {
+	if l.rate <= 0 {
+		return 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+// releaseBody calls release when the response body is closed.
+type releaseBody struct {
+	io.ReadCloser
+
+	release func()
+}
+
+// Close closes the response body and releases the in-flight slot.
+func (r *releaseBody) Close() error {
+	defer r.release()
+	return r.ReadCloser.Close() //nolint:wrapcheck
+}
@@ -0,0 +1,93 @@
+package starr_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+)
+
+func TestLimiterInFlight(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu      sync.Mutex
+		current int
+		highest int
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		current++
+		highest = max(highest, current)
+		mu.Unlock()
+
+		defer func() {
+			mu.Lock()
+			current--
+			mu.Unlock()
+		}()
+
+		time.Sleep(10 * time.Millisecond)
+		writer.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := starr.New("apiKey", server.URL, 0)
+	config.Limiter = starr.NewLimiter(0, 0, 2)
+
+	var wg sync.WaitGroup
+
+	for range 10 {
+		wg.Go(func() {
+			assert.NoError(t, config.DeleteAny(context.Background(), starr.Request{URI: "/"}))
+		})
+	}
+
+	wg.Wait()
+	assert.LessOrEqual(t, highest, 2, "too many requests in flight")
+}
+
+func TestLimiterRate(t *testing.T) {
+	t.Parallel()
+
+	limiter := starr.NewLimiter(100, 1, 0)
+	start := time.Now()
+
+	for range 5 {
+		release, err := limiter.Wait(context.Background())
+		require.NoError(t, err)
+		release()
+	}
+
+	// 1 burst token, then 4 more at 10ms each.
+	assert.GreaterOrEqual(t, time.Since(start), 35*time.Millisecond)
+}
+
+func TestLimiterCancel(t *testing.T) {
+	t.Parallel()
+
+	limiter := starr.NewLimiter(0, 0, 1)
+
+	release, err := limiter.Wait(context.Background())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = limiter.Wait(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	release()
+	release() // must be safe to call twice.
+
+	release, err = limiter.Wait(context.Background())
+	require.NoError(t, err)
+	release()
+}
@@ -1,6 +1,9 @@
 package starr
 
 import (
+	"context"
+	"iter"
+	"maps"
 	"net/url"
 	"strconv"
 	"strings"
@@ -186,3 +189,54 @@ func AdjustPerPage(records, total, collected, perPage int) int {
 
 	return perPage
 }
+
+// PageFetcher retrieves a single page of records from a page-able API endpoint.
+// It returns the records on the page, and the total number of records in the app.
+type PageFetcher[T any] func(ctx context.Context, params *PageReq) (records []T, total int, err error)
+
+// Paginate returns an iterator over every record from a page-able API endpoint.
+// Pages are fetched lazily, one at a time, as the consumer ranges over the records.
+// Fetching stops when the consumer breaks out of the loop, when all records have been
+// returned, or after the first error. The error is yielded with a zero-value record.
+// The input params are copied and not modified. Page defaults to 1, and PageSize defaults to 500.
+// This is used by the *All() iterator methods in the starr modules.
+func Paginate[T any](ctx context.Context, params *PageReq, fetch PageFetcher[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		req := params.clone()
+		req.Page = max(req.Page, 1)
+		req.PageSize = SetPerPage(0, req.PageSize)
+
+		for ; ; req.Page++ {
+			records, total, err := fetch(ctx, req)
+			if err != nil {
+				var zero T
+
+				yield(zero, err)
+
+				return
+			}
+
+			for _, record := range records {
+				if !yield(record, nil) {
+					return
+				}
+			}
+
+			if len(records) == 0 || req.Page*req.PageSize >= total {
+				return
+			}
+		}
+	}
+}
+
+// clone returns a copy of the page request, or an empty one if it's nil.
+func (r *PageReq) clone() *PageReq {
+	if r == nil {
+		return &PageReq{}
+	}
+
+	clone := *r
+	clone.Values = maps.Clone(r.Values)
+
+	return &clone
+}
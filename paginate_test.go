@@ -0,0 +1,85 @@
+package starr_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+)
+
+var errTestFetch = errors.New("fetch failed")
+
+// fakePages returns a fetcher that serves total records, and counts the pages fetched.
+func fakePages(total int, fetched *int) starr.PageFetcher[int] {
+	return func(_ context.Context, params *starr.PageReq) ([]int, int, error) {
+		*fetched++
+
+		records := []int{}
+		for i := (params.Page - 1) * params.PageSize; i < total && i < params.Page*params.PageSize; i++ {
+			records = append(records, i)
+		}
+
+		return records, total, nil
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	t.Parallel()
+
+	fetched := 0
+	params := &starr.PageReq{PageSize: 3}
+	got := []int{}
+
+	for record, err := range starr.Paginate(context.Background(), params, fakePages(10, &fetched)) {
+		require.NoError(t, err)
+
+		got = append(got, record)
+	}
+
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, got)
+	assert.Equal(t, 4, fetched, "wrong number of pages fetched")
+	assert.Equal(t, 0, params.Page, "input params must not be modified")
+}
+
+func TestPaginateBreak(t *testing.T) {
+	t.Parallel()
+
+	fetched := 0
+
+	for record, err := range starr.Paginate(context.Background(), &starr.PageReq{PageSize: 3}, fakePages(100, &fetched)) {
+		require.NoError(t, err)
+
+		if record == 4 {
+			break
+		}
+	}
+
+	assert.Equal(t, 2, fetched, "pages must be fetched lazily")
+}
+
+func TestPaginateError(t *testing.T) {
+	t.Parallel()
+
+	fetch := func(_ context.Context, params *starr.PageReq) ([]int, int, error) {
+		if params.Page > 1 {
+			return nil, 0, errTestFetch
+		}
+
+		return []int{1, 2}, 4, nil
+	}
+
+	count := 0
+
+	for _, err := range starr.Paginate(context.Background(), &starr.PageReq{PageSize: 2}, fetch) {
+		if count++; count < 3 {
+			require.NoError(t, err)
+		} else {
+			require.ErrorIs(t, err, errTestFetch)
+		}
+	}
+
+	assert.Equal(t, 3, count, "the error must be yielded once, then stop")
+}
@@ -147,6 +147,62 @@ func (p *Prowlarr) DeleteApplicationContext(ctx context.Context, id int64) error
 	return nil
 }
 
+// BulkApplication is the input to UpdateApplications. SyncLevel is one of disabled, addOnly or fullSync.
+type BulkApplication struct {
+	IDs       []int64         `json:"ids"`
+	Tags      []int           `json:"tags,omitempty"`
+	ApplyTags starr.ApplyTags `json:"applyTags,omitempty"`
+	SyncLevel string          `json:"syncLevel,omitempty"`
+}
+
+// UpdateApplications bulk updates connected applications. Use it to re-tag many applications,
+// or change their sync level, at once.
+func (p *Prowlarr) UpdateApplications(bulk *BulkApplication) ([]*ApplicationOutput, error) {
+	return p.UpdateApplicationsContext(context.Background(), bulk)
+}
+
+// UpdateApplicationsContext bulk updates connected applications. Use it to re-tag many applications,
+// or change their sync level, at once.
+func (p *Prowlarr) UpdateApplicationsContext(ctx context.Context, bulk *BulkApplication) ([]*ApplicationOutput, error) {
+	var (
+		output []*ApplicationOutput
+		body   bytes.Buffer
+	)
+
+	if err := json.NewEncoder(&body).Encode(bulk); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpApplication, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpApplication, "bulk"), Body: &body}
+	if err := p.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteApplications bulk deletes connected applications by their IDs.
+func (p *Prowlarr) DeleteApplications(ids []int64) error {
+	return p.DeleteApplicationsContext(context.Background(), ids)
+}
+
+// DeleteApplicationsContext bulk deletes connected applications by their IDs.
+func (p *Prowlarr) DeleteApplicationsContext(ctx context.Context, ids []int64) error {
+	input := starr.BulkDelete{IDs: ids}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpApplication, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpApplication, "bulk"), Body: &body}
+	if err := p.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
 // TestApplication tests connection settings for an application definition.
 func (p *Prowlarr) TestApplication(app *ApplicationInput, forceTest bool) error {
 	return p.TestApplicationContext(context.Background(), app, forceTest)
@@ -117,3 +117,34 @@ func (p *Prowlarr) DeleteCommandContext(ctx context.Context, commandID int64) er
 
 	return nil
 }
+
+// CommandResult is returned by WaitForCommand and SendCommandAndWait.
+type CommandResult = starr.CommandResult[CommandResponse]
+
+// WaitForCommand polls a command, with backoff, until it completes, fails or is aborted.
+// A failed command is not an error; check the Status. If the context is cancelled,
+// the last known status is returned with the context error.
+func (p *Prowlarr) WaitForCommand(ctx context.Context, commandID int64) (*CommandResult, error) {
+	return starr.WaitForCommand(ctx, func(ctx context.Context) (*CommandResponse, starr.CommandStatus, error) {
+		output, err := p.GetCommandStatusContext(ctx, commandID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, starr.CommandStatus(output.Status), nil
+	})
+}
+
+// SendCommandAndWait sends a command to Prowlarr and waits for it to finish. See WaitForCommand.
+func (p *Prowlarr) SendCommandAndWait(ctx context.Context, cmd *CommandRequest) (*CommandResult, error) {
+	output, err := p.SendCommandContext(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	if output.ID == 0 {
+		return &CommandResult{Command: output, Status: starr.CommandStatus(output.Status)}, nil
+	}
+
+	return p.WaitForCommand(ctx, output.ID)
+}
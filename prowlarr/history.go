@@ -3,6 +3,7 @@ package prowlarr
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"path"
 	"time"
@@ -53,6 +54,19 @@ func (p *Prowlarr) GetHistoryPageContext(ctx context.Context, params *starr.Page
 	return &output, nil
 }
 
+// HistoryAll returns an iterator over every History record (grabs/failures/completed) from Prowlarr.
+// Pages are fetched lazily as the loop runs, and the loop may break early. See starr.Paginate for more.
+func (p *Prowlarr) HistoryAll(ctx context.Context, params *starr.PageReq) iter.Seq2[*HistoryRecord, error] {
+	return starr.Paginate(ctx, params, func(ctx context.Context, req *starr.PageReq) ([]*HistoryRecord, int, error) {
+		page, err := p.GetHistoryPageContext(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Records, page.TotalRecords, nil
+	})
+}
+
 // GetHistorySince returns history since a date.
 func (p *Prowlarr) GetHistorySince(date time.Time, eventType string) ([]*HistoryRecord, error) {
 	return p.GetHistorySinceContext(context.Background(), date, eventType)
@@ -0,0 +1,117 @@
+package prowlarr
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"path"
+
+	"golift.io/starr"
+	"golift.io/starr/starrshared"
+)
+
+const bpLog = APIver + "/log"
+
+// LogLine is one record from /api/v1/log.
+type LogLine = starrshared.LogLine
+
+// LogPage is a page of log lines from /api/v1/log.
+type LogPage = starrshared.LogPage
+
+// LogFile describes a log file on disk.
+type LogFile = starrshared.LogFile
+
+// LogTailOptions filter and pace the log lines from TailLogs.
+type LogTailOptions = starrshared.TailOptions
+
+// GetLogPage returns a page of application log lines.
+func (p *Prowlarr) GetLogPage(params *starr.PageReq) (*LogPage, error) {
+	return p.GetLogPageContext(context.Background(), params)
+}
+
+// GetLogPageContext returns a page of application log lines.
+func (p *Prowlarr) GetLogPageContext(ctx context.Context, params *starr.PageReq) (*LogPage, error) {
+	var output LogPage
+
+	req := starr.Request{URI: bpLog, Query: params.Params()}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetLogFiles returns the list of log files.
+func (p *Prowlarr) GetLogFiles() ([]*LogFile, error) {
+	return p.GetLogFilesContext(context.Background())
+}
+
+// GetLogFilesContext returns the list of log files.
+func (p *Prowlarr) GetLogFilesContext(ctx context.Context) ([]*LogFile, error) {
+	var output []*LogFile
+
+	req := starr.Request{URI: path.Join(bpLog, "file")}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetLogFile returns the contents of a named log file.
+func (p *Prowlarr) GetLogFile(filename string) (*LogFile, error) {
+	return p.GetLogFileContext(context.Background(), filename)
+}
+
+// GetLogFileContext returns the contents of a named log file.
+func (p *Prowlarr) GetLogFileContext(ctx context.Context, filename string) (*LogFile, error) {
+	var output LogFile
+
+	req := starr.Request{URI: path.Join(bpLog, "file", filename)}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateLogFiles triggers a log file update/roll.
+func (p *Prowlarr) UpdateLogFiles() ([]*LogFile, error) {
+	return p.UpdateLogFilesContext(context.Background())
+}
+
+// UpdateLogFilesContext triggers a log file update/roll.
+func (p *Prowlarr) UpdateLogFilesContext(ctx context.Context) ([]*LogFile, error) {
+	var output []*LogFile
+
+	req := starr.Request{URI: path.Join(bpLog, "file", "update")}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// UpdateLogFile triggers update for a specific log file.
+func (p *Prowlarr) UpdateLogFile(filename string) ([]*LogFile, error) {
+	return p.UpdateLogFileContext(context.Background(), filename)
+}
+
+// UpdateLogFileContext triggers update for a specific log file.
+func (p *Prowlarr) UpdateLogFileContext(ctx context.Context, filename string) ([]*LogFile, error) {
+	var output []*LogFile
+
+	req := starr.Request{URI: path.Join(bpLog, "file", "update", filename)}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// TailLogs returns an iterator that polls the log and yields new lines, oldest first, as they are logged.
+// It runs until the context is cancelled or the loop breaks. Filter lines by level and logger in the options.
+// A failed poll is yielded with a nil line, and ends the loop. See starrshared.TailLogs for more.
+func (p *Prowlarr) TailLogs(ctx context.Context, opts *LogTailOptions) iter.Seq2[*LogLine, error] {
+	return starrshared.TailLogs(ctx, p.GetLogPageContext, opts)
+}
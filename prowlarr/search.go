@@ -33,6 +33,7 @@ type Search struct {
 	PublishDate  time.Time      `json:"publishDate"`
 	CommentURL   string         `json:"commentUrl"`
 	DownloadURL  string         `json:"downloadUrl"`
+	MagnetURL    string         `json:"magnetUrl"`
 	InfoURL      string         `json:"infoUrl"`
 	IndexerFlags []string       `json:"indexerFlags,omitempty"`
 	Categories   []*Category    `json:"categories"`
@@ -69,13 +69,13 @@ func (p *Prowlarr) GetSystemStatusContext(ctx context.Context) (*SystemStatus, e
 }
 
 // GetBackupFiles returns all available Prowlarr backup files.
-// Use GetBody to download a file using BackupFile.Path.
+// Use starr.Config.DownloadBackup to download one of the files.
 func (p *Prowlarr) GetBackupFiles() ([]*starr.BackupFile, error) {
 	return p.GetBackupFilesContext(context.Background())
 }
 
 // GetBackupFilesContext returns all available Prowlarr backup files.
-// Use GetBody to download a file using BackupFile.Path.
+// Use starr.Config.DownloadBackup to download one of the files.
 func (p *Prowlarr) GetBackupFilesContext(ctx context.Context) ([]*starr.BackupFile, error) {
 	var output []*starr.BackupFile
 
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"path"
 	"time"
@@ -94,6 +95,19 @@ func (r *Radarr) GetBlockListPageContext(ctx context.Context, params *starr.Page
 	return &output, nil
 }
 
+// BlockListAll returns an iterator over every Block List record from Radarr.
+// Pages are fetched lazily as the loop runs, and the loop may break early. See starr.Paginate for more.
+func (r *Radarr) BlockListAll(ctx context.Context, params *starr.PageReq) iter.Seq2[*BlockListRecord, error] {
+	return starr.Paginate(ctx, params, func(ctx context.Context, req *starr.PageReq) ([]*BlockListRecord, int, error) {
+		page, err := r.GetBlockListPageContext(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Records, page.TotalRecords, nil
+	})
+}
+
 // DeleteBlockList removes a single block list item.
 func (r *Radarr) DeleteBlockList(listID int64) error {
 	return r.DeleteBlockListContext(context.Background(), listID)
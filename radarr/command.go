@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path"
 	"time"
 
 	"golift.io/starr"
@@ -13,10 +14,15 @@ import (
 const bpCommand = APIver + "/command"
 
 // CommandRequest goes into the /api/v3/command endpoint.
-// This was created from the search command and may not support other commands yet.
+// Create one with a command function, like RefreshMovieCommand, and pass it to SendCommand.
 type CommandRequest struct {
-	Name     string  `json:"name"`
-	MovieIDs []int64 `json:"movieIds,omitempty"`
+	Name             string  `json:"name"`
+	MovieIDs         []int64 `json:"movieIds,omitempty"`
+	MovieID          int64   `json:"movieId,omitempty"`
+	Files            []int64 `json:"files,omitempty"`            // RenameFiles only
+	Path             string  `json:"path,omitempty"`             // DownloadedMoviesScan only
+	DownloadClientID string  `json:"downloadClientId,omitempty"` // DownloadedMoviesScan only
+	ImportMode       string  `json:"importMode,omitempty"`       // DownloadedMoviesScan only
 }
 
 // CommandResponse comes from the /api/v3/command endpoint.
@@ -81,3 +87,55 @@ func (r *Radarr) SendCommandContext(ctx context.Context, cmd *CommandRequest) (*
 
 	return &output, nil
 }
+
+// GetCommandStatus returns the status of an already started command.
+func (r *Radarr) GetCommandStatus(commandID int64) (*CommandResponse, error) {
+	return r.GetCommandStatusContext(context.Background(), commandID)
+}
+
+// GetCommandStatusContext returns the status of an already started command.
+func (r *Radarr) GetCommandStatusContext(ctx context.Context, commandID int64) (*CommandResponse, error) {
+	var output CommandResponse
+
+	if commandID == 0 {
+		return &output, nil
+	}
+
+	req := starr.Request{URI: path.Join(bpCommand, starr.Str(commandID))}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// CommandResult is returned by WaitForCommand and SendCommandAndWait.
+type CommandResult = starr.CommandResult[CommandResponse]
+
+// WaitForCommand polls a command, with backoff, until it completes, fails or is aborted.
+// A failed command is not an error; check the Status. If the context is cancelled,
+// the last known status is returned with the context error.
+func (r *Radarr) WaitForCommand(ctx context.Context, commandID int64) (*CommandResult, error) {
+	return starr.WaitForCommand(ctx, func(ctx context.Context) (*CommandResponse, starr.CommandStatus, error) {
+		output, err := r.GetCommandStatusContext(ctx, commandID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, starr.CommandStatus(output.Status), nil
+	})
+}
+
+// SendCommandAndWait sends a command to Radarr and waits for it to finish. See WaitForCommand.
+func (r *Radarr) SendCommandAndWait(ctx context.Context, cmd *CommandRequest) (*CommandResult, error) {
+	output, err := r.SendCommandContext(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	if output.ID == 0 {
+		return &CommandResult{Command: output, Status: starr.CommandStatus(output.Status)}, nil
+	}
+
+	return r.WaitForCommand(ctx, output.ID)
+}
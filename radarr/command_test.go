@@ -1,6 +1,7 @@
 package radarr_test
 
 import (
+	"encoding/json"
 	"net/http"
 	"path"
 	"testing"
@@ -146,3 +147,42 @@ func TestSendCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestWaitForCommand(t *testing.T) {
+	t.Parallel()
+
+	fake := starrtest.NewFakeServer(t, starr.Radarr, "")
+	client := radarr.New(starr.New(fake.APIKey, fake.URL, 0))
+
+	cmd, err := client.SendCommand(&radarr.CommandRequest{Name: "RefreshMovie"})
+	require.NoError(t, err)
+
+	result, err := client.WaitForCommand(t.Context(), cmd.ID)
+	require.NoError(t, err)
+	assert.Equal(t, starr.CommandCompleted, result.Status)
+	assert.Equal(t, cmd.ID, result.Command.ID)
+
+	_, err = client.GetCommandStatus(cmd.ID + 1)
+	require.ErrorIs(t, err, &starr.ReqError{Code: http.StatusNotFound})
+}
+
+func TestCommandConstructors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		cmd      *radarr.CommandRequest
+		expected string
+	}{
+		{radarr.MoviesSearchCommand(1, 2), `{"name":"MoviesSearch","movieIds":[1,2]}`},
+		{radarr.RescanMovieCommand(4), `{"name":"RescanMovie","movieId":4}`},
+		{radarr.RenameFilesCommand(4, 10), `{"name":"RenameFiles","movieId":4,"files":[10]}`},
+		{radarr.DownloadedMoviesScanCommand("/downloads/film", "", radarr.ImportModeCopy), `{"name":"DownloadedMoviesScan","path":"/downloads/film","importMode":"copy"}`},
+		{radarr.BackupCommand(), `{"name":"Backup"}`},
+	}
+
+	for _, test := range tests {
+		output, err := json.Marshal(test.cmd)
+		require.NoError(t, err)
+		assert.JSONEq(t, test.expected, string(output), test.cmd.Name)
+	}
+}
@@ -0,0 +1,114 @@
+package radarr
+
+/* This file contains a constructor for each command Radarr accepts on the /command endpoint.
+ * The names and bodies come from the *Command classes in the Radarr source code.
+ * Pass the output of any of these to SendCommand or SendCommandAndWait.
+ */
+
+// Import modes for DownloadedMoviesScanCommand.
+const (
+	ImportModeAuto = "auto"
+	ImportModeMove = "move"
+	ImportModeCopy = "copy"
+)
+
+// RefreshMovieCommand refreshes metadata for movies, and rescans their files. No IDs refreshes every movie.
+func RefreshMovieCommand(movieIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "RefreshMovie", MovieIDs: movieIDs}
+}
+
+// RescanMovieCommand rescans the files for a movie. A zero ID rescans every movie.
+func RescanMovieCommand(movieID int64) *CommandRequest {
+	return &CommandRequest{Name: "RescanMovie", MovieID: movieID}
+}
+
+// MoviesSearchCommand searches for one or more movies.
+func MoviesSearchCommand(movieIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "MoviesSearch", MovieIDs: movieIDs}
+}
+
+// MissingMoviesSearchCommand searches for every missing monitored movie.
+func MissingMoviesSearchCommand() *CommandRequest {
+	return &CommandRequest{Name: "MissingMoviesSearch"}
+}
+
+// CutoffUnmetMoviesSearchCommand searches for upgrades to every movie that has not met its cutoff.
+func CutoffUnmetMoviesSearchCommand() *CommandRequest {
+	return &CommandRequest{Name: "CutoffUnmetMoviesSearch"}
+}
+
+// RenameFilesCommand renames movie files for a movie. Get the file IDs from GetRenames.
+func RenameFilesCommand(movieID int64, fileIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "RenameFiles", MovieID: movieID, Files: fileIDs}
+}
+
+// RenameMovieCommand renames the files for one or more movies.
+func RenameMovieCommand(movieIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "RenameMovie", MovieIDs: movieIDs}
+}
+
+// RefreshCollectionsCommand refreshes every movie collection.
+func RefreshCollectionsCommand() *CommandRequest {
+	return &CommandRequest{Name: "RefreshCollections"}
+}
+
+// DownloadedMoviesScanCommand imports finished downloads from a path. All inputs are optional.
+// Without a path, every download client is checked. The import mode may be auto, move or copy.
+func DownloadedMoviesScanCommand(path, downloadClientID, importMode string) *CommandRequest {
+	return &CommandRequest{
+		Name:             "DownloadedMoviesScan",
+		Path:             path,
+		DownloadClientID: downloadClientID,
+		ImportMode:       importMode,
+	}
+}
+
+// RssSyncCommand checks every indexer's RSS feed for new releases.
+func RssSyncCommand() *CommandRequest {
+	return &CommandRequest{Name: "RssSync"}
+}
+
+// RefreshMonitoredDownloadsCommand checks download clients for finished downloads.
+func RefreshMonitoredDownloadsCommand() *CommandRequest {
+	return &CommandRequest{Name: "RefreshMonitoredDownloads"}
+}
+
+// ImportListSyncCommand syncs every import list.
+func ImportListSyncCommand() *CommandRequest {
+	return &CommandRequest{Name: "ImportListSync"}
+}
+
+// BackupCommand creates a manual backup. Find it with GetBackupFiles.
+func BackupCommand() *CommandRequest {
+	return &CommandRequest{Name: "Backup"}
+}
+
+// CheckHealthCommand runs every health check.
+func CheckHealthCommand() *CommandRequest {
+	return &CommandRequest{Name: "CheckHealth"}
+}
+
+// ApplicationCheckUpdateCommand checks for an application update.
+func ApplicationCheckUpdateCommand() *CommandRequest {
+	return &CommandRequest{Name: "ApplicationCheckUpdate"}
+}
+
+// ApplicationUpdateCommand installs an available application update.
+func ApplicationUpdateCommand() *CommandRequest {
+	return &CommandRequest{Name: "ApplicationUpdate"}
+}
+
+// ClearBlocklistCommand removes every item from the blocklist.
+func ClearBlocklistCommand() *CommandRequest {
+	return &CommandRequest{Name: "ClearBlocklist"}
+}
+
+// CleanUpRecycleBinCommand empties old files from the recycle bin.
+func CleanUpRecycleBinCommand() *CommandRequest {
+	return &CommandRequest{Name: "CleanUpRecycleBin"}
+}
+
+// HousekeepingCommand runs the housekeeping (database cleanup) tasks.
+func HousekeepingCommand() *CommandRequest {
+	return &CommandRequest{Name: "Housekeeping"}
+}
@@ -142,3 +142,51 @@ func (r *Radarr) DeleteCustomFormatContext(ctx context.Context, cfID int64) erro
 
 	return nil
 }
+
+// UpdateCustomFormats bulk updates custom formats.
+func (r *Radarr) UpdateCustomFormats(bulk *starr.BulkCustomFormat) ([]*CustomFormatOutput, error) {
+	return r.UpdateCustomFormatsContext(context.Background(), bulk)
+}
+
+// UpdateCustomFormatsContext bulk updates custom formats.
+func (r *Radarr) UpdateCustomFormatsContext(
+	ctx context.Context, bulk *starr.BulkCustomFormat,
+) ([]*CustomFormatOutput, error) {
+	var (
+		output []*CustomFormatOutput
+		body   bytes.Buffer
+	)
+
+	if err := json.NewEncoder(&body).Encode(bulk); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpCustomFormat, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpCustomFormat, "bulk"), Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteCustomFormats bulk deletes custom formats by their IDs.
+func (r *Radarr) DeleteCustomFormats(ids []int64) error {
+	return r.DeleteCustomFormatsContext(context.Background(), ids)
+}
+
+// DeleteCustomFormatsContext bulk deletes custom formats by their IDs.
+func (r *Radarr) DeleteCustomFormatsContext(ctx context.Context, ids []int64) error {
+	input := starr.BulkDelete{IDs: ids}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpCustomFormat, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpCustomFormat, "bulk"), Body: &body}
+	if err := r.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
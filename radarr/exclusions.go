@@ -83,6 +83,26 @@ func (r *Radarr) DeleteExclusionsContext(ctx context.Context, ids []int64) error
 	return nil
 }
 
+// DeleteExclusionsBulk removes exclusions from Radarr with one request.
+func (r *Radarr) DeleteExclusionsBulk(ids []int64) error {
+	return r.DeleteExclusionsBulkContext(context.Background(), ids)
+}
+
+// DeleteExclusionsBulkContext removes exclusions from Radarr with one request.
+func (r *Radarr) DeleteExclusionsBulkContext(ctx context.Context, ids []int64) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(starr.BulkDelete{IDs: ids}); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpExclusions, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpExclusions, "bulk"), Body: &body}
+	if err := r.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
 // AddExclusions adds multiple exclusions to Radarr.
 func (r *Radarr) AddExclusions(exclusions []*Exclusion) error {
 	return r.AddExclusionsContext(context.Background(), exclusions)
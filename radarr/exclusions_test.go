@@ -0,0 +1,84 @@
+package radarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestDeleteExclusions(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "exclusions", "3"),
+			ExpectedMethod: "DELETE",
+			WithRequest:    []int64{3},
+			ResponseStatus: 200,
+			ResponseBody:   "{}",
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "exclusions", "3"),
+			ExpectedMethod: "DELETE",
+			WithRequest:    []int64{3},
+			ResponseStatus: 404,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      starr.ErrRequestError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.DeleteExclusions(test.WithRequest.([]int64))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+		})
+	}
+}
+
+func TestDeleteExclusionsBulk(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "exclusions", "bulk"),
+			ExpectedMethod:  "DELETE",
+			ExpectedRequest: `{"ids":[3,4]}` + "\n",
+			WithRequest:     []int64{3, 4},
+			ResponseStatus:  200,
+			ResponseBody:    "{}",
+			WithError:       nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "exclusions", "bulk"),
+			ExpectedMethod:  "DELETE",
+			ExpectedRequest: `{"ids":[3,4]}` + "\n",
+			WithRequest:     []int64{3, 4},
+			ResponseStatus:  404,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.DeleteExclusionsBulk(test.WithRequest.([]int64))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+		})
+	}
+}
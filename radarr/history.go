@@ -3,6 +3,7 @@ package radarr
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"path"
 	"time"
@@ -119,6 +120,19 @@ func (r *Radarr) GetHistoryPageContext(ctx context.Context, params *starr.PageRe
 	return &output, nil
 }
 
+// HistoryAll returns an iterator over every History record (grabs/failures/completed) from Radarr.
+// Pages are fetched lazily as the loop runs, and the loop may break early. See starr.Paginate for more.
+func (r *Radarr) HistoryAll(ctx context.Context, params *starr.PageReq) iter.Seq2[*HistoryRecord, error] {
+	return starr.Paginate(ctx, params, func(ctx context.Context, req *starr.PageReq) ([]*HistoryRecord, int, error) {
+		page, err := r.GetHistoryPageContext(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Records, page.TotalRecords, nil
+	})
+}
+
 // GetHistoryByMovieID returns history records for a movie.
 func (r *Radarr) GetHistoryByMovieID(movieID int64, eventType string, includeMovie bool) ([]*HistoryRecord, error) {
 	return r.GetHistoryByMovieIDContext(context.Background(), movieID, eventType, includeMovie)
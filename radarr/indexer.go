@@ -9,10 +9,15 @@ import (
 	"path"
 
 	"golift.io/starr"
+	"golift.io/starr/starrshared"
 )
 
 const bpIndexer = APIver + "/indexer"
 
+// ProviderTestResult is the result for one indexer, download client, notification or other provider
+// from a testall endpoint.
+type ProviderTestResult = starrshared.ProviderTestResult
+
 // IndexerInput is the input for a new or updated indexer.
 type IndexerInput struct {
 	EnableAutomaticSearch   bool                `json:"enableAutomaticSearch"`
@@ -194,3 +199,65 @@ func (r *Radarr) UpdateIndexersContext(ctx context.Context, indexer *starr.BulkI
 
 	return output, nil
 }
+
+// GetIndexerSchema returns a template for every indexer implementation the app has.
+func (r *Radarr) GetIndexerSchema() ([]*IndexerOutput, error) {
+	return r.GetIndexerSchemaContext(context.Background())
+}
+
+// GetIndexerSchemaContext returns a template for every indexer implementation the app has.
+func (r *Radarr) GetIndexerSchemaContext(ctx context.Context) ([]*IndexerOutput, error) {
+	var output []*IndexerOutput
+
+	req := starr.Request{URI: path.Join(bpIndexer, "schema")}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// TestAllIndexers tests every indexer.
+// Validation failures are returned in the results, not as an error.
+func (r *Radarr) TestAllIndexers() ([]*ProviderTestResult, error) {
+	return r.TestAllIndexersContext(context.Background())
+}
+
+// TestAllIndexersContext tests every indexer.
+// Validation failures are returned in the results, not as an error.
+func (r *Radarr) TestAllIndexersContext(ctx context.Context) ([]*ProviderTestResult, error) {
+	var output []*ProviderTestResult
+
+	req := starr.Request{URI: path.Join(bpIndexer, "testall")}
+	if err := r.PostInto(ctx, req, &output); err != nil && !starrshared.TestResultsFromError(err, &output) {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// IndexerAction runs a named action on an indexer, like the ones its settings form uses
+// to fill in options. The reply depends on the action, so it is returned as raw JSON.
+func (r *Radarr) IndexerAction(name string, indexer *IndexerInput) (json.RawMessage, error) {
+	return r.IndexerActionContext(context.Background(), name, indexer)
+}
+
+// IndexerActionContext runs a named action on an indexer, like the ones its settings form uses
+// to fill in options. The reply depends on the action, so it is returned as raw JSON.
+func (r *Radarr) IndexerActionContext(
+	ctx context.Context, name string, indexer *IndexerInput,
+) (json.RawMessage, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(indexer); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpIndexer, err)
+	}
+
+	var output json.RawMessage
+
+	req := starr.Request{URI: path.Join(bpIndexer, "action", path.Base(name)), Body: &body}
+	if err := r.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
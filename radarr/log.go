@@ -0,0 +1,117 @@
+package radarr
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"path"
+
+	"golift.io/starr"
+	"golift.io/starr/starrshared"
+)
+
+const bpLog = APIver + "/log"
+
+// LogLine is one record from /api/v3/log.
+type LogLine = starrshared.LogLine
+
+// LogPage is a page of log lines from /api/v3/log.
+type LogPage = starrshared.LogPage
+
+// LogFile describes a log file on disk.
+type LogFile = starrshared.LogFile
+
+// LogTailOptions filter and pace the log lines from TailLogs.
+type LogTailOptions = starrshared.TailOptions
+
+// GetLogPage returns a page of application log lines.
+func (r *Radarr) GetLogPage(params *starr.PageReq) (*LogPage, error) {
+	return r.GetLogPageContext(context.Background(), params)
+}
+
+// GetLogPageContext returns a page of application log lines.
+func (r *Radarr) GetLogPageContext(ctx context.Context, params *starr.PageReq) (*LogPage, error) {
+	var output LogPage
+
+	req := starr.Request{URI: bpLog, Query: params.Params()}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetLogFiles returns the list of log files.
+func (r *Radarr) GetLogFiles() ([]*LogFile, error) {
+	return r.GetLogFilesContext(context.Background())
+}
+
+// GetLogFilesContext returns the list of log files.
+func (r *Radarr) GetLogFilesContext(ctx context.Context) ([]*LogFile, error) {
+	var output []*LogFile
+
+	req := starr.Request{URI: path.Join(bpLog, "file")}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetLogFile returns the contents of a named log file.
+func (r *Radarr) GetLogFile(filename string) (*LogFile, error) {
+	return r.GetLogFileContext(context.Background(), filename)
+}
+
+// GetLogFileContext returns the contents of a named log file.
+func (r *Radarr) GetLogFileContext(ctx context.Context, filename string) (*LogFile, error) {
+	var output LogFile
+
+	req := starr.Request{URI: path.Join(bpLog, "file", filename)}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateLogFiles triggers a log file update/roll.
+func (r *Radarr) UpdateLogFiles() ([]*LogFile, error) {
+	return r.UpdateLogFilesContext(context.Background())
+}
+
+// UpdateLogFilesContext triggers a log file update/roll.
+func (r *Radarr) UpdateLogFilesContext(ctx context.Context) ([]*LogFile, error) {
+	var output []*LogFile
+
+	req := starr.Request{URI: path.Join(bpLog, "file", "update")}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// UpdateLogFile triggers update for a specific log file.
+func (r *Radarr) UpdateLogFile(filename string) ([]*LogFile, error) {
+	return r.UpdateLogFileContext(context.Background(), filename)
+}
+
+// UpdateLogFileContext triggers update for a specific log file.
+func (r *Radarr) UpdateLogFileContext(ctx context.Context, filename string) ([]*LogFile, error) {
+	var output []*LogFile
+
+	req := starr.Request{URI: path.Join(bpLog, "file", "update", filename)}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// TailLogs returns an iterator that polls the log and yields new lines, oldest first, as they are logged.
+// It runs until the context is cancelled or the loop breaks. Filter lines by level and logger in the options.
+// A failed poll is yielded with a nil line, and ends the loop. See starrshared.TailLogs for more.
+func (r *Radarr) TailLogs(ctx context.Context, opts *LogTailOptions) iter.Seq2[*LogLine, error] {
+	return starrshared.TailLogs(ctx, r.GetLogPageContext, opts)
+}
@@ -56,6 +56,24 @@ type Movie struct {
 	Popularity            float64             `json:"popularity"`
 	OriginalLanguage      *starr.Value        `json:"originalLanguage,omitempty"`
 	AddOptions            *AddMovieOptions    `json:"addOptions,omitempty"` // only available upon adding a movie.
+	// Unknown holds JSON fields this library does not model, so an update sends them back unchanged.
+	Unknown starr.UnknownFields `json:"-"`
+}
+
+// UnmarshalJSON decodes a movie, and saves any fields this library does not model in Unknown.
+func (m *Movie) UnmarshalJSON(data []byte) error {
+	type movie Movie
+
+	unknown, err := starr.DecodeUnknown(data, (*movie)(m))
+	m.Unknown = unknown
+
+	return err //nolint:wrapcheck // already wrapped.
+}
+
+// MarshalJSON encodes a movie, including any fields saved in Unknown.
+func (m Movie) MarshalJSON() ([]byte, error) {
+	type movie Movie
+	return starr.EncodeUnknown(movie(m), m.Unknown) //nolint:wrapcheck // already wrapped.
 }
 
 // MovieCollection is the collection summary embedded in a Movie payload.
@@ -6,13 +6,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"path"
 	"time"
 
 	"golift.io/starr"
+	"golift.io/starr/starrshared"
 )
 
 const bpRelease = APIver + "/release"
 
+// ReleasePush is the input for PushRelease.
+type ReleasePush = starrshared.ReleasePush
+
 // Release is the output from the Radarr release endpoint.
 type Release struct {
 	ID                  int64          `json:"id"`
@@ -137,3 +142,29 @@ func (r *Radarr) GrabReleaseContext(ctx context.Context, release *Release) (*Rel
 
 	return &output, nil
 }
+
+// PushRelease sends a release found elsewhere to Radarr. Radarr evaluates it like an RSS release,
+// and grabs it if it's wanted. The output has the decision, including any rejections.
+func (r *Radarr) PushRelease(push *ReleasePush) ([]*Release, error) {
+	return r.PushReleaseContext(context.Background(), push)
+}
+
+// PushReleaseContext sends a release found elsewhere to Radarr. Radarr evaluates it like an RSS release,
+// and grabs it if it's wanted. The output has the decision, including any rejections.
+func (r *Radarr) PushReleaseContext(ctx context.Context, push *ReleasePush) ([]*Release, error) {
+	uri := path.Join(bpRelease, "push")
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(push); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", uri, err)
+	}
+
+	var output []*Release
+
+	req := starr.Request{URI: uri, Body: &body}
+	if err := r.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
@@ -67,13 +67,13 @@ func (r *Radarr) GetSystemStatusContext(ctx context.Context) (*SystemStatus, err
 }
 
 // GetBackupFiles returns all available Radarr backup files.
-// Use GetBody to download a file using BackupFile.Path.
+// Use starr.Config.DownloadBackup to download one of the files.
 func (r *Radarr) GetBackupFiles() ([]*starr.BackupFile, error) {
 	return r.GetBackupFilesContext(context.Background())
 }
 
 // GetBackupFilesContext returns all available Radarr backup files.
-// Use GetBody to download a file using BackupFile.Path.
+// Use starr.Config.DownloadBackup to download one of the files.
 func (r *Radarr) GetBackupFilesContext(ctx context.Context) ([]*starr.BackupFile, error) {
 	var output []*starr.BackupFile
 
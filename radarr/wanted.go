@@ -3,6 +3,7 @@ package radarr
 import (
 	"context"
 	"fmt"
+	"iter"
 	"path"
 
 	"golift.io/starr"
@@ -48,6 +49,19 @@ func (r *Radarr) GetWantedMissingPageContext(ctx context.Context, params *starr.
 	return &output, nil
 }
 
+// WantedMissingAll returns an iterator over every missing movie from Radarr.
+// Pages are fetched lazily as the loop runs, and the loop may break early. See starr.Paginate for more.
+func (r *Radarr) WantedMissingAll(ctx context.Context, params *starr.PageReq) iter.Seq2[*Movie, error] {
+	return starr.Paginate(ctx, params, func(ctx context.Context, req *starr.PageReq) ([]*Movie, int, error) {
+		page, err := r.GetWantedMissingPageContext(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Records, page.TotalRecords, nil
+	})
+}
+
 // GetWantedCutoffPage returns a page of movies past quality cutoff.
 func (r *Radarr) GetWantedCutoffPage(params *starr.PageReq) (*WantedMoviesPage, error) {
 	return r.GetWantedCutoffPageContext(context.Background(), params)
@@ -67,3 +81,16 @@ func (r *Radarr) GetWantedCutoffPageContext(ctx context.Context, params *starr.P
 
 	return &output, nil
 }
+
+// WantedCutoffAll returns an iterator over every movie past quality cutoff from Radarr.
+// Pages are fetched lazily as the loop runs, and the loop may break early. See starr.Paginate for more.
+func (r *Radarr) WantedCutoffAll(ctx context.Context, params *starr.PageReq) iter.Seq2[*Movie, error] {
+	return starr.Paginate(ctx, params, func(ctx context.Context, req *starr.PageReq) ([]*Movie, int, error) {
+		page, err := r.GetWantedCutoffPageContext(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Records, page.TotalRecords, nil
+	})
+}
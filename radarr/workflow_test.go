@@ -0,0 +1,58 @@
+package radarr_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/starrtest"
+)
+
+// TestWorkflow adds and updates a movie, searches for it, and checks the queue using the stateful fake server.
+func TestWorkflow(t *testing.T) {
+	t.Parallel()
+
+	fake := starrtest.NewFakeServer(t, starr.Radarr, "someKey")
+	client := radarr.New(starr.New(fake.APIKey, fake.URL, 0))
+
+	profileIDs, err := fake.Seed(starrtest.FakeQualityProfile, &radarr.QualityProfile{Name: "HD"})
+	require.NoError(t, err)
+
+	movie, err := client.AddMovie(&radarr.AddMovieInput{Title: "Some Movie", TmdbID: 9876, QualityProfileID: profileIDs[0]})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), movie.ID)
+
+	// A field this library does not model must survive a read-modify-write.
+	_, err = fake.Seed(starrtest.FakeMovie, map[string]any{
+		"title": "Other Movie", "tmdbId": 5432, "qualityProfileId": profileIDs[0], "someNewField": "keep me",
+	})
+	require.NoError(t, err)
+
+	movies, err := client.GetMovie(&radarr.GetMovie{TMDBID: 5432})
+	require.NoError(t, err)
+	require.Len(t, movies, 1)
+
+	movies[0].Monitored = true
+	updated, err := client.UpdateMovie(movies[0].ID, movies[0], false)
+	require.NoError(t, err)
+	assert.True(t, updated.Monitored)
+	assert.JSONEq(t, `"keep me"`, string(updated.Unknown["someNewField"]))
+
+	cmd, err := client.SendCommand(&radarr.CommandRequest{Name: "MoviesSearch", MovieIDs: []int64{movie.ID, updated.ID}})
+	require.NoError(t, err)
+	assert.Equal(t, "queued", cmd.Status)
+
+	queue, err := client.GetQueue(0, 1)
+	require.NoError(t, err)
+	require.Len(t, queue.Records, 2)
+	assert.Equal(t, movie.ID, queue.Records[0].MovieID)
+	assert.Equal(t, updated.ID, queue.Records[1].MovieID)
+
+	require.NoError(t, client.DeleteMovie(movie.ID, false, false))
+
+	_, err = client.GetMovieByID(movie.ID)
+	require.ErrorIs(t, err, &starr.ReqError{Code: http.StatusNotFound})
+}
@@ -107,6 +107,24 @@ type Author struct {
 	AuthorNameLastFirst string         `json:"authorNameLastFirst"`
 	MonitorNewItems     string         `json:"monitorNewItems"`
 	SortNameLastFirst   string         `json:"sortNameLastFirst"`
+	// Unknown holds JSON fields this library does not model, so an update sends them back unchanged.
+	Unknown starr.UnknownFields `json:"-"`
+}
+
+// UnmarshalJSON decodes an author, and saves any fields this library does not model in Unknown.
+func (a *Author) UnmarshalJSON(data []byte) error {
+	type author Author
+
+	unknown, err := starr.DecodeUnknown(data, (*author)(a))
+	a.Unknown = unknown
+
+	return err //nolint:wrapcheck // already wrapped.
+}
+
+// MarshalJSON encodes an author, including any fields saved in Unknown.
+func (a Author) MarshalJSON() ([]byte, error) {
+	type author Author
+	return starr.EncodeUnknown(author(a), a.Unknown) //nolint:wrapcheck // already wrapped.
 }
 
 // AuthorBook is part of an Author, and is very different from a normal Book type.
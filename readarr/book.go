@@ -39,6 +39,24 @@ type Book struct {
 	Title          string         `json:"title"`
 	TitleSlug      string         `json:"titleSlug"`
 	Author         *Author        `json:"author"`
+	// Unknown holds JSON fields this library does not model, so an update sends them back unchanged.
+	Unknown starr.UnknownFields `json:"-"`
+}
+
+// UnmarshalJSON decodes a book, and saves any fields this library does not model in Unknown.
+func (b *Book) UnmarshalJSON(data []byte) error {
+	type book Book
+
+	unknown, err := starr.DecodeUnknown(data, (*book)(b))
+	b.Unknown = unknown
+
+	return err //nolint:wrapcheck // already wrapped.
+}
+
+// MarshalJSON encodes a book, including any fields saved in Unknown.
+func (b Book) MarshalJSON() ([]byte, error) {
+	type book Book
+	return starr.EncodeUnknown(book(b), b.Unknown) //nolint:wrapcheck // already wrapped.
 }
 
 // Edition is more Book meta data.
@@ -50,8 +68,10 @@ type Edition struct {
 	Isbn13           string         `json:"isbn13"`
 	Asin             string         `json:"asin"`
 	Title            string         `json:"title"`
+	Language         string         `json:"language"`
 	Overview         string         `json:"overview"`
 	Format           string         `json:"format"`
+	Disambiguation   string         `json:"disambiguation"`
 	Publisher        string         `json:"publisher"`
 	PageCount        int            `json:"pageCount"`
 	ReleaseDate      time.Time      `json:"releaseDate"`
@@ -61,6 +81,7 @@ type Edition struct {
 	Monitored        bool           `json:"monitored"`
 	ManualAdd        bool           `json:"manualAdd"`
 	IsEbook          bool           `json:"isEbook"`
+	RemoteCover      string         `json:"remoteCover,omitempty"`
 }
 
 // AddBookInput is the input to add a book.
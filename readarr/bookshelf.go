@@ -0,0 +1,68 @@
+package readarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"golift.io/starr"
+)
+
+const bpBookshelf = APIver + "/bookshelf"
+
+// MonitoringOptions configures bookshelf monitoring.
+// Monitor is one of all, future, missing, existing, latest, first or none.
+type MonitoringOptions struct {
+	Monitor        string   `json:"monitor,omitempty"`
+	BooksToMonitor []string `json:"booksToMonitor,omitempty"`
+	Monitored      bool     `json:"monitored"`
+}
+
+// BookshelfAuthor is one author block for the bookshelf. A nil Monitored leaves the author's state alone.
+type BookshelfAuthor struct {
+	ID        int64            `json:"id"`
+	Monitored *bool            `json:"monitored,omitempty"`
+	Books     []*BookshelfBook `json:"books,omitempty"`
+}
+
+// BookshelfBook is one book for a bookshelf author.
+type BookshelfBook struct {
+	ID        int64 `json:"id"`
+	Monitored bool  `json:"monitored"`
+}
+
+// BookshelfInput is the body for POST /bookshelf.
+// MonitorNewItems is one of all, none or new, and is left alone if empty.
+type BookshelfInput struct {
+	Authors           []*BookshelfAuthor `json:"authors"`
+	MonitoringOptions *MonitoringOptions `json:"monitoringOptions,omitempty"`
+	MonitorNewItems   string             `json:"monitorNewItems,omitempty"`
+}
+
+// UpdateBookshelf sets the monitored state of many authors and their books at once, like the mass editor.
+func (r *Readarr) UpdateBookshelf(input *BookshelfInput) error {
+	return r.UpdateBookshelfContext(context.Background(), input)
+}
+
+// UpdateBookshelfContext sets the monitored state of many authors and their books at once, like the mass editor.
+// Readarr replies with an empty body; any 2xx status is success.
+func (r *Readarr) UpdateBookshelfContext(ctx context.Context, input *BookshelfInput) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpBookshelf, err)
+	}
+
+	req := starr.Request{URI: starr.SetAPIPath(bpBookshelf), Body: &body}
+
+	resp, err := r.Post(ctx, req)
+	if err != nil {
+		return fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package readarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/readarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestUpdateBookshelf(t *testing.T) {
+	t.Parallel()
+
+	input := &readarr.BookshelfInput{
+		Authors:           []*readarr.BookshelfAuthor{{ID: 3, Books: []*readarr.BookshelfBook{{ID: 9}}}},
+		MonitoringOptions: &readarr.MonitoringOptions{Monitor: "none"},
+	}
+	expected := `{"authors":[{"id":3,"books":[{"id":9,"monitored":false}]}],` +
+		`"monitoringOptions":{"monitor":"none","monitored":false}}` + "\n"
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "bookshelf"),
+			ExpectedMethod:  "POST",
+			ResponseStatus:  http.StatusOK,
+			WithRequest:     input,
+			ExpectedRequest: expected,
+		},
+		{
+			Name:            "202",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "bookshelf"),
+			ExpectedMethod:  "POST",
+			ResponseStatus:  http.StatusAccepted,
+			WithRequest:     input,
+			ExpectedRequest: expected,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "bookshelf"),
+			ExpectedMethod:  "POST",
+			ResponseStatus:  http.StatusNotFound,
+			WithRequest:     input,
+			ExpectedRequest: expected,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.UpdateBookshelf(test.WithRequest.(*readarr.BookshelfInput))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+		})
+	}
+}
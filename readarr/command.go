@@ -14,11 +14,18 @@ import (
 const bpCommand = APIver + "/command"
 
 // CommandRequest goes into the /api/v1/command endpoint.
-// This was created from the search command and may not support other commands yet.
+// Create one with a command function, like RefreshAuthorCommand, and pass it to SendCommand.
 type CommandRequest struct {
-	Name    string  `json:"name"`
-	BookIDs []int64 `json:"bookIds,omitempty"`
-	BookID  int64   `json:"bookId,omitempty"`
+	Name             string   `json:"name"`
+	BookIDs          []int64  `json:"bookIds,omitempty"`
+	BookID           int64    `json:"bookId,omitempty"`
+	AuthorID         int64    `json:"authorId,omitempty"`
+	AuthorIDs        []int64  `json:"authorIds,omitempty"`
+	Folders          []string `json:"folders,omitempty"`
+	Files            []int64  `json:"files,omitempty"`            // RenameFiles and RetagFiles only
+	Path             string   `json:"path,omitempty"`             // DownloadedBooksScan only
+	DownloadClientID string   `json:"downloadClientId,omitempty"` // DownloadedBooksScan only
+	ImportMode       string   `json:"importMode,omitempty"`       // DownloadedBooksScan only
 }
 
 // CommandResponse comes from the /api/v1/command endpoint.
@@ -106,3 +113,34 @@ func (r *Readarr) GetCommandStatusContext(ctx context.Context, commandID int64)
 
 	return &output, nil
 }
+
+// CommandResult is returned by WaitForCommand and SendCommandAndWait.
+type CommandResult = starr.CommandResult[CommandResponse]
+
+// WaitForCommand polls a command, with backoff, until it completes, fails or is aborted.
+// A failed command is not an error; check the Status. If the context is cancelled,
+// the last known status is returned with the context error.
+func (r *Readarr) WaitForCommand(ctx context.Context, commandID int64) (*CommandResult, error) {
+	return starr.WaitForCommand(ctx, func(ctx context.Context) (*CommandResponse, starr.CommandStatus, error) {
+		output, err := r.GetCommandStatusContext(ctx, commandID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, starr.CommandStatus(output.Status), nil
+	})
+}
+
+// SendCommandAndWait sends a command to Readarr and waits for it to finish. See WaitForCommand.
+func (r *Readarr) SendCommandAndWait(ctx context.Context, cmd *CommandRequest) (*CommandResult, error) {
+	output, err := r.SendCommandContext(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	if output.ID == 0 {
+		return &CommandResult{Command: output, Status: starr.CommandStatus(output.Status)}, nil
+	}
+
+	return r.WaitForCommand(ctx, output.ID)
+}
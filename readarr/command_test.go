@@ -1,6 +1,7 @@
 package readarr_test
 
 import (
+	"encoding/json"
 	"net/http"
 	"path"
 	"testing"
@@ -147,3 +148,23 @@ func TestSendCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestCommandConstructors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		cmd      *readarr.CommandRequest
+		expected string
+	}{
+		{readarr.RefreshAuthorCommand(5), `{"name":"RefreshAuthor","authorId":5}`},
+		{readarr.BookSearchCommand(1, 2), `{"name":"BookSearch","bookIds":[1,2]}`},
+		{readarr.RenameAuthorCommand(5, 6), `{"name":"RenameAuthor","authorIds":[5,6]}`},
+		{readarr.DownloadedBooksScanCommand("/downloads/book", "", readarr.ImportModeAuto), `{"name":"DownloadedBooksScan","path":"/downloads/book","importMode":"auto"}`},
+	}
+
+	for _, test := range tests {
+		output, err := json.Marshal(test.cmd)
+		require.NoError(t, err)
+		assert.JSONEq(t, test.expected, string(output), test.cmd.Name)
+	}
+}
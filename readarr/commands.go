@@ -0,0 +1,129 @@
+package readarr
+
+/* This file contains a constructor for each command Readarr accepts on the /command endpoint.
+ * The names and bodies come from the *Command classes in the Readarr source code.
+ * Pass the output of any of these to SendCommand or SendCommandAndWait.
+ */
+
+// Import modes for DownloadedBooksScanCommand.
+const (
+	ImportModeAuto = "auto"
+	ImportModeMove = "move"
+	ImportModeCopy = "copy"
+)
+
+// RefreshAuthorCommand refreshes metadata for an author, and rescans their files. A zero ID refreshes every author.
+func RefreshAuthorCommand(authorID int64) *CommandRequest {
+	return &CommandRequest{Name: "RefreshAuthor", AuthorID: authorID}
+}
+
+// RefreshBookCommand refreshes metadata for a book.
+func RefreshBookCommand(bookID int64) *CommandRequest {
+	return &CommandRequest{Name: "RefreshBook", BookID: bookID}
+}
+
+// RescanFoldersCommand rescans root folders for new files. No folders rescans every root folder.
+func RescanFoldersCommand(folders ...string) *CommandRequest {
+	return &CommandRequest{Name: "RescanFolders", Folders: folders}
+}
+
+// AuthorSearchCommand searches for every missing monitored book by an author.
+func AuthorSearchCommand(authorID int64) *CommandRequest {
+	return &CommandRequest{Name: "AuthorSearch", AuthorID: authorID}
+}
+
+// BookSearchCommand searches for one or more books.
+func BookSearchCommand(bookIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "BookSearch", BookIDs: bookIDs}
+}
+
+// MissingBookSearchCommand searches for every missing monitored book.
+func MissingBookSearchCommand() *CommandRequest {
+	return &CommandRequest{Name: "MissingBookSearch"}
+}
+
+// CutoffUnmetBookSearchCommand searches for upgrades to every book that has not met its cutoff.
+func CutoffUnmetBookSearchCommand() *CommandRequest {
+	return &CommandRequest{Name: "CutoffUnmetBookSearch"}
+}
+
+// RenameFilesCommand renames book files for an author. Get the file IDs from GetAuthorRenames.
+func RenameFilesCommand(authorID int64, fileIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "RenameFiles", AuthorID: authorID, Files: fileIDs}
+}
+
+// RenameAuthorCommand renames every book file for one or more authors.
+func RenameAuthorCommand(authorIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "RenameAuthor", AuthorIDs: authorIDs}
+}
+
+// RetagFilesCommand writes tags to book files for an author.
+func RetagFilesCommand(authorID int64, fileIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "RetagFiles", AuthorID: authorID, Files: fileIDs}
+}
+
+// RetagAuthorCommand writes tags to every book file for one or more authors.
+func RetagAuthorCommand(authorIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "RetagAuthor", AuthorIDs: authorIDs}
+}
+
+// DownloadedBooksScanCommand imports finished downloads from a path. All inputs are optional.
+// Without a path, every download client is checked. The import mode may be auto, move or copy.
+func DownloadedBooksScanCommand(path, downloadClientID, importMode string) *CommandRequest {
+	return &CommandRequest{
+		Name:             "DownloadedBooksScan",
+		Path:             path,
+		DownloadClientID: downloadClientID,
+		ImportMode:       importMode,
+	}
+}
+
+// RssSyncCommand checks every indexer's RSS feed for new releases.
+func RssSyncCommand() *CommandRequest {
+	return &CommandRequest{Name: "RssSync"}
+}
+
+// RefreshMonitoredDownloadsCommand checks download clients for finished downloads.
+func RefreshMonitoredDownloadsCommand() *CommandRequest {
+	return &CommandRequest{Name: "RefreshMonitoredDownloads"}
+}
+
+// ImportListSyncCommand syncs every import list.
+func ImportListSyncCommand() *CommandRequest {
+	return &CommandRequest{Name: "ImportListSync"}
+}
+
+// BackupCommand creates a manual backup. Find it with GetBackupFiles.
+func BackupCommand() *CommandRequest {
+	return &CommandRequest{Name: "Backup"}
+}
+
+// CheckHealthCommand runs every health check.
+func CheckHealthCommand() *CommandRequest {
+	return &CommandRequest{Name: "CheckHealth"}
+}
+
+// ApplicationCheckUpdateCommand checks for an application update.
+func ApplicationCheckUpdateCommand() *CommandRequest {
+	return &CommandRequest{Name: "ApplicationCheckUpdate"}
+}
+
+// ApplicationUpdateCommand installs an available application update.
+func ApplicationUpdateCommand() *CommandRequest {
+	return &CommandRequest{Name: "ApplicationUpdate"}
+}
+
+// ClearBlocklistCommand removes every item from the blocklist.
+func ClearBlocklistCommand() *CommandRequest {
+	return &CommandRequest{Name: "ClearBlocklist"}
+}
+
+// CleanUpRecycleBinCommand empties old files from the recycle bin.
+func CleanUpRecycleBinCommand() *CommandRequest {
+	return &CommandRequest{Name: "CleanUpRecycleBin"}
+}
+
+// HousekeepingCommand runs the housekeeping (database cleanup) tasks.
+func HousekeepingCommand() *CommandRequest {
+	return &CommandRequest{Name: "Housekeeping"}
+}
@@ -0,0 +1,32 @@
+package readarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"golift.io/starr"
+)
+
+const bpEdition = APIver + "/edition"
+
+// GetEditions returns the editions of one or more books.
+func (r *Readarr) GetEditions(bookIDs ...int64) ([]*Edition, error) {
+	return r.GetEditionsContext(context.Background(), bookIDs...)
+}
+
+// GetEditionsContext returns the editions of one or more books.
+func (r *Readarr) GetEditionsContext(ctx context.Context, bookIDs ...int64) ([]*Edition, error) {
+	var output []*Edition
+
+	req := starr.Request{URI: bpEdition, Query: make(url.Values)}
+	for _, bookID := range bookIDs {
+		req.Query.Add("bookId", starr.Str(bookID))
+	}
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"iter"
 	"path"
 	"time"
 
@@ -117,6 +118,19 @@ func (r *Readarr) GetHistoryPageContext(ctx context.Context, params *starr.PageR
 	return &output, nil
 }
 
+// HistoryAll returns an iterator over every History record (grabs/failures/completed) from Readarr.
+// Pages are fetched lazily as the loop runs, and the loop may break early. See starr.Paginate for more.
+func (r *Readarr) HistoryAll(ctx context.Context, params *starr.PageReq) iter.Seq2[HistoryRecord, error] {
+	return starr.Paginate(ctx, params, func(ctx context.Context, req *starr.PageReq) ([]HistoryRecord, int, error) {
+		page, err := r.GetHistoryPageContext(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Records, page.TotalRecords, nil
+	})
+}
+
 // Fail marks the given history item as failed by id.
 func (r *Readarr) Fail(historyID int64) error {
 	return r.FailContext(context.Background(), historyID)
@@ -0,0 +1,243 @@
+package readarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/readarr"
+	"golift.io/starr/starrtest"
+)
+
+const metadataProfileBody = `{"id": 2, "name": "Standard", "minPopularity": 3.5, "skipMissingDate": true,
+"skipMissingIsbn": false, "skipPartsAndSets": true, "skipSeriesSecondary": false,
+"allowedLanguages": "eng,null", "minPages": 10, "ignored": ["abridged"]}`
+
+//nolint:gochecknoglobals // test data.
+var metadataProfileOutput = &readarr.MetadataProfile{
+	ID:               2,
+	Name:             "Standard",
+	MinPopularity:    3.5,
+	SkipMissingDate:  true,
+	SkipPartsAndSets: true,
+	AllowedLanguages: "eng,null",
+	MinPages:         10,
+	Ignored:          []string{"abridged"},
+}
+
+func TestGetMetadataProfiles(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "metadataprofile"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			ResponseBody:   "[" + metadataProfileBody + "]",
+			WithResponse:   []*readarr.MetadataProfile{metadataProfileOutput},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "metadataprofile"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   []*readarr.MetadataProfile(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetMetadataProfiles()
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGetMetadataProfile(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "metadataprofile", "2"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			WithRequest:    int64(2),
+			ResponseBody:   metadataProfileBody,
+			WithResponse:   metadataProfileOutput,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "metadataprofile", "2"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			WithRequest:    int64(2),
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*readarr.MetadataProfile)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetMetadataProfile(test.WithRequest.(int64))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestAddMetadataProfile(t *testing.T) {
+	t.Parallel()
+
+	request := `{"id":0,"name":"Standard","minPopularity":3.5,"skipMissingDate":true,"skipMissingIsbn":false,` +
+		`"skipPartsAndSets":true,"skipSeriesSecondary":false,"minPages":10}` + "\n"
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "metadataprofile"),
+			ExpectedMethod: "POST",
+			ResponseStatus: 200,
+			WithRequest: &readarr.MetadataProfile{
+				ID:               5, // Add must not send an ID.
+				Name:             "Standard",
+				MinPopularity:    3.5,
+				SkipMissingDate:  true,
+				SkipPartsAndSets: true,
+				MinPages:         10,
+			},
+			ExpectedRequest: request,
+			ResponseBody:    metadataProfileBody,
+			WithResponse:    metadataProfileOutput,
+		},
+		{
+			Name:           "400",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "metadataprofile"),
+			ExpectedMethod: "POST",
+			ResponseStatus: 400,
+			WithRequest: &readarr.MetadataProfile{
+				Name:             "Standard",
+				MinPopularity:    3.5,
+				SkipMissingDate:  true,
+				SkipPartsAndSets: true,
+				MinPages:         10,
+			},
+			ExpectedRequest: request,
+			ResponseBody:    `{"message": "Name must be unique"}`,
+			WithError:       &starr.ReqError{Code: http.StatusBadRequest},
+			WithResponse:    (*readarr.MetadataProfile)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.AddMetadataProfile(test.WithRequest.(*readarr.MetadataProfile))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestUpdateMetadataProfile(t *testing.T) {
+	t.Parallel()
+
+	profile := &readarr.MetadataProfile{
+		ID:               2,
+		Name:             "Standard",
+		MinPopularity:    3.5,
+		SkipMissingDate:  true,
+		SkipPartsAndSets: true,
+		AllowedLanguages: "eng,null",
+		MinPages:         10,
+		Ignored:          []string{"abridged"},
+	}
+	request := `{"id":2,"name":"Standard","minPopularity":3.5,"skipMissingDate":true,"skipMissingIsbn":false,` +
+		`"skipPartsAndSets":true,"skipSeriesSecondary":false,"allowedLanguages":"eng,null","minPages":10,` +
+		`"ignored":["abridged"]}` + "\n"
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "metadataprofile", "2"),
+			ExpectedMethod:  "PUT",
+			ResponseStatus:  200,
+			WithRequest:     profile,
+			ExpectedRequest: request,
+			ResponseBody:    metadataProfileBody,
+			WithResponse:    metadataProfileOutput,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "metadataprofile", "2"),
+			ExpectedMethod:  "PUT",
+			ResponseStatus:  404,
+			WithRequest:     profile,
+			ExpectedRequest: request,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:    (*readarr.MetadataProfile)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.UpdateMetadataProfile(test.WithRequest.(*readarr.MetadataProfile))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestDeleteMetadataProfile(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "metadataprofile", "2"),
+			ExpectedMethod: "DELETE",
+			ResponseStatus: 200,
+			WithRequest:    int64(2),
+			ResponseBody:   "{}",
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "metadataprofile", "2"),
+			ExpectedMethod: "DELETE",
+			ResponseStatus: 404,
+			WithRequest:    int64(2),
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.DeleteMetadataProfile(test.WithRequest.(int64))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+		})
+	}
+}
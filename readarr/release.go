@@ -0,0 +1,89 @@
+package readarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"time"
+
+	"golift.io/starr"
+	"golift.io/starr/starrshared"
+)
+
+const bpRelease = APIver + "/release"
+
+// ReleasePush is the input for PushRelease.
+type ReleasePush = starrshared.ReleasePush
+
+// ReleaseOutput is the output from the Readarr release endpoint.
+type ReleaseOutput struct {
+	ID                  int64          `json:"id"`
+	GUID                string         `json:"guid"`
+	Quality             *starr.Quality `json:"quality"`
+	QualityWeight       int64          `json:"qualityWeight"`
+	Age                 int64          `json:"age"`
+	AgeHours            float64        `json:"ageHours"`
+	AgeMinutes          float64        `json:"ageMinutes"`
+	Size                int64          `json:"size"`
+	IndexerID           int64          `json:"indexerId"`
+	Indexer             string         `json:"indexer"`
+	ReleaseGroup        string         `json:"releaseGroup"`
+	SubGroup            string         `json:"subGroup"`
+	ReleaseHash         string         `json:"releaseHash"`
+	Title               string         `json:"title"`
+	Discography         bool           `json:"discography"`
+	SceneSource         bool           `json:"sceneSource"`
+	AirDate             string         `json:"airDate"`
+	AuthorName          string         `json:"authorName"`
+	BookTitle           string         `json:"bookTitle"`
+	Approved            bool           `json:"approved"`
+	TemporarilyRejected bool           `json:"temporarilyRejected"`
+	Rejected            bool           `json:"rejected"`
+	Rejections          []string       `json:"rejections"`
+	PublishDate         time.Time      `json:"publishDate"`
+	CommentURL          string         `json:"commentUrl"`
+	DownloadURL         string         `json:"downloadUrl"`
+	InfoURL             string         `json:"infoUrl"`
+	DownloadAllowed     bool           `json:"downloadAllowed"`
+	ReleaseWeight       int64          `json:"releaseWeight"`
+	CustomFormats       []any          `json:"customFormats"`
+	CustomFormatScore   int64          `json:"customFormatScore"`
+	MagnetURL           string         `json:"magnetUrl"`
+	InfoHash            string         `json:"infoHash"`
+	Seeders             int            `json:"seeders"`
+	Leechers            int            `json:"leechers"`
+	Protocol            starr.Protocol `json:"protocol"`
+	IndexerFlags        int64          `json:"indexerFlags,omitempty"`
+	AuthorID            int64          `json:"authorId"`
+	BookID              int64          `json:"bookId"`
+	DownloadClientID    int64          `json:"downloadClientId"`
+	DownloadClient      string         `json:"downloadClient"`
+}
+
+// PushRelease sends a release found elsewhere to Readarr. Readarr evaluates it like an RSS release,
+// and grabs it if it's wanted. The output has the decision, including any rejections.
+func (r *Readarr) PushRelease(push *ReleasePush) (*ReleaseOutput, error) {
+	return r.PushReleaseContext(context.Background(), push)
+}
+
+// PushReleaseContext sends a release found elsewhere to Readarr. Readarr evaluates it like an RSS release,
+// and grabs it if it's wanted. The output has the decision, including any rejections.
+func (r *Readarr) PushReleaseContext(ctx context.Context, push *ReleasePush) (*ReleaseOutput, error) {
+	uri := path.Join(bpRelease, "push")
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(push); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", uri, err)
+	}
+
+	var output ReleaseOutput
+
+	req := starr.Request{URI: uri, Body: &body}
+	if err := r.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
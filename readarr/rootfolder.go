@@ -1,30 +1,42 @@
 package readarr
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"path"
 
 	"golift.io/starr"
 )
 
 const bpRootFolder = APIver + "/rootFolder"
 
-// RootFolder is the /api/v1/rootfolder endpoint.
+// RootFolder is the /api/v1/rootfolder endpoint. Name, Path and both default profiles are required to add one.
+// The defaults are used for authors added from this folder by import lists and the import process.
+// Set IsCalibreLibrary and the Calibre content server fields to manage the folder with Calibre.
 type RootFolder struct {
-	ID                       int64  `json:"id"`
-	Name                     string `json:"name"`
-	Path                     string `json:"path"`
-	DefaultMetadataProfileID int64  `json:"defaultMetadataProfileId"`
-	DefaultQualityProfileID  int64  `json:"defaultQualityProfileId"`
-	DefaultMonitorOption     string `json:"defaultMonitorOption"`
-	DefaultTags              []int  `json:"defaultTags"`
-	Port                     int    `json:"port"`
-	OutputProfile            string `json:"outputProfile"`
-	UseSsl                   bool   `json:"useSsl"`
-	Accessible               bool   `json:"accessible"`
-	IsCalibreLibrary         bool   `json:"isCalibreLibrary"`
-	FreeSpace                int64  `json:"freeSpace"`
-	TotalSpace               int64  `json:"totalSpace"`
+	ID                          int64  `json:"id"`
+	Name                        string `json:"name"`
+	Path                        string `json:"path"`
+	DefaultMetadataProfileID    int64  `json:"defaultMetadataProfileId"`
+	DefaultQualityProfileID     int64  `json:"defaultQualityProfileId"`
+	DefaultMonitorOption        string `json:"defaultMonitorOption"`                  // all, future, missing, etc.
+	DefaultNewItemMonitorOption string `json:"defaultNewItemMonitorOption,omitempty"` // all, none or new.
+	DefaultTags                 []int  `json:"defaultTags"`
+	IsCalibreLibrary            bool   `json:"isCalibreLibrary"`
+	Host                        string `json:"host,omitempty"`
+	Port                        int    `json:"port"`
+	URLBase                     string `json:"urlBase,omitempty"`
+	Username                    string `json:"username,omitempty"`
+	Password                    string `json:"password,omitempty"`
+	Library                     string `json:"library,omitempty"`
+	OutputFormat                string `json:"outputFormat,omitempty"`
+	OutputProfile               string `json:"outputProfile"`
+	UseSsl                      bool   `json:"useSsl"`
+	Accessible                  bool   `json:"accessible"`
+	FreeSpace                   int64  `json:"freeSpace"`
+	TotalSpace                  int64  `json:"totalSpace"`
 }
 
 // GetRootFolders returns all configured root folders.
@@ -43,3 +55,79 @@ func (r *Readarr) GetRootFoldersContext(ctx context.Context) ([]*RootFolder, err
 
 	return output, nil
 }
+
+// GetRootFolder returns a single root folder.
+func (r *Readarr) GetRootFolder(folderID int64) (*RootFolder, error) {
+	return r.GetRootFolderContext(context.Background(), folderID)
+}
+
+// GetRootFolderContext returns a single root folder.
+func (r *Readarr) GetRootFolderContext(ctx context.Context, folderID int64) (*RootFolder, error) {
+	var output RootFolder
+
+	req := starr.Request{URI: path.Join(bpRootFolder, starr.Str(folderID))}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// AddRootFolder creates a root folder.
+func (r *Readarr) AddRootFolder(folder *RootFolder) (*RootFolder, error) {
+	return r.AddRootFolderContext(context.Background(), folder)
+}
+
+// AddRootFolderContext creates a root folder.
+func (r *Readarr) AddRootFolderContext(ctx context.Context, folder *RootFolder) (*RootFolder, error) {
+	var output RootFolder
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(folder); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpRootFolder, err)
+	}
+
+	req := starr.Request{URI: bpRootFolder, Body: &body}
+	if err := r.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateRootFolder updates a root folder. The path cannot be changed.
+func (r *Readarr) UpdateRootFolder(folder *RootFolder) (*RootFolder, error) {
+	return r.UpdateRootFolderContext(context.Background(), folder)
+}
+
+// UpdateRootFolderContext updates a root folder. The path cannot be changed.
+func (r *Readarr) UpdateRootFolderContext(ctx context.Context, folder *RootFolder) (*RootFolder, error) {
+	var output RootFolder
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(folder); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpRootFolder, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpRootFolder, starr.Str(folder.ID)), Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// DeleteRootFolder removes a single root folder.
+func (r *Readarr) DeleteRootFolder(folderID int64) error {
+	return r.DeleteRootFolderContext(context.Background(), folderID)
+}
+
+// DeleteRootFolderContext removes a single root folder.
+func (r *Readarr) DeleteRootFolderContext(ctx context.Context, folderID int64) error {
+	req := starr.Request{URI: path.Join(bpRootFolder, starr.Str(folderID))}
+	if err := r.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
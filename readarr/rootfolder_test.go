@@ -0,0 +1,255 @@
+package readarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/readarr"
+	"golift.io/starr/starrtest"
+)
+
+const calibreRootFolder = `{"id": 2, "name": "Calibre", "path": "/books/calibre", "defaultMetadataProfileId": 1,
+"defaultQualityProfileId": 3, "defaultMonitorOption": "all", "defaultTags": [4], "isCalibreLibrary": true,
+"host": "calibre", "port": 8080, "library": "Books", "outputProfile": "default", "useSsl": false, "accessible": true}`
+
+//nolint:gochecknoglobals // test data.
+var calibreRootFolderOutput = &readarr.RootFolder{
+	ID:                       2,
+	Name:                     "Calibre",
+	Path:                     "/books/calibre",
+	DefaultMetadataProfileID: 1,
+	DefaultQualityProfileID:  3,
+	DefaultMonitorOption:     "all",
+	DefaultTags:              []int{4},
+	IsCalibreLibrary:         true,
+	Host:                     "calibre",
+	Port:                     8080,
+	Library:                  "Books",
+	OutputProfile:            "default",
+	Accessible:               true,
+}
+
+func TestGetRootFolders(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "rootFolder"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			ResponseBody:   "[" + calibreRootFolder + "]",
+			WithResponse:   []*readarr.RootFolder{calibreRootFolderOutput},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "rootFolder"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   []*readarr.RootFolder(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetRootFolders()
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGetRootFolder(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "rootFolder", "2"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			WithRequest:    int64(2),
+			ResponseBody:   calibreRootFolder,
+			WithResponse:   calibreRootFolderOutput,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "rootFolder", "2"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			WithRequest:    int64(2),
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*readarr.RootFolder)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetRootFolder(test.WithRequest.(int64))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestAddRootFolder(t *testing.T) {
+	t.Parallel()
+
+	folder := &readarr.RootFolder{
+		Name:                     "Calibre",
+		Path:                     "/books/calibre",
+		DefaultMetadataProfileID: 1,
+		DefaultQualityProfileID:  3,
+		DefaultMonitorOption:     "all",
+		DefaultTags:              []int{4},
+		IsCalibreLibrary:         true,
+		Host:                     "calibre",
+		Port:                     8080,
+		Library:                  "Books",
+		OutputProfile:            "default",
+	}
+	request := `{"id":0,"name":"Calibre","path":"/books/calibre","defaultMetadataProfileId":1,` +
+		`"defaultQualityProfileId":3,"defaultMonitorOption":"all","defaultTags":[4],"isCalibreLibrary":true,` +
+		`"host":"calibre","port":8080,"library":"Books","outputProfile":"default","useSsl":false,` +
+		`"accessible":false,"freeSpace":0,"totalSpace":0}` + "\n"
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "rootFolder"),
+			ExpectedMethod:  "POST",
+			ResponseStatus:  200,
+			WithRequest:     folder,
+			ExpectedRequest: request,
+			ResponseBody:    calibreRootFolder,
+			WithResponse:    calibreRootFolderOutput,
+		},
+		{
+			Name:            "400",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "rootFolder"),
+			ExpectedMethod:  "POST",
+			ResponseStatus:  400,
+			WithRequest:     folder,
+			ExpectedRequest: request,
+			ResponseBody:    `{"message": "Invalid Path"}`,
+			WithError:       &starr.ReqError{Code: http.StatusBadRequest},
+			WithResponse:    (*readarr.RootFolder)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.AddRootFolder(test.WithRequest.(*readarr.RootFolder))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestUpdateRootFolder(t *testing.T) {
+	t.Parallel()
+
+	folder := &readarr.RootFolder{
+		ID:                       2,
+		Name:                     "Calibre",
+		Path:                     "/books/calibre",
+		DefaultMetadataProfileID: 1,
+		DefaultQualityProfileID:  3,
+		DefaultMonitorOption:     "all",
+		DefaultTags:              []int{4},
+		IsCalibreLibrary:         true,
+		Host:                     "calibre",
+		Port:                     8080,
+		Library:                  "Books",
+		OutputProfile:            "default",
+	}
+	request := `{"id":2,"name":"Calibre","path":"/books/calibre","defaultMetadataProfileId":1,` +
+		`"defaultQualityProfileId":3,"defaultMonitorOption":"all","defaultTags":[4],"isCalibreLibrary":true,` +
+		`"host":"calibre","port":8080,"library":"Books","outputProfile":"default","useSsl":false,` +
+		`"accessible":false,"freeSpace":0,"totalSpace":0}` + "\n"
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "rootFolder", "2"),
+			ExpectedMethod:  "PUT",
+			ResponseStatus:  200,
+			WithRequest:     folder,
+			ExpectedRequest: request,
+			ResponseBody:    calibreRootFolder,
+			WithResponse:    calibreRootFolderOutput,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "rootFolder", "2"),
+			ExpectedMethod:  "PUT",
+			ResponseStatus:  404,
+			WithRequest:     folder,
+			ExpectedRequest: request,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:    (*readarr.RootFolder)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.UpdateRootFolder(test.WithRequest.(*readarr.RootFolder))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestDeleteRootFolder(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "rootFolder", "2"),
+			ExpectedMethod: "DELETE",
+			ResponseStatus: 200,
+			WithRequest:    int64(2),
+			ResponseBody:   "{}",
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "rootFolder", "2"),
+			ExpectedMethod: "DELETE",
+			ResponseStatus: 404,
+			WithRequest:    int64(2),
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.DeleteRootFolder(test.WithRequest.(int64))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+		})
+	}
+}
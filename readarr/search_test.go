@@ -1,6 +1,7 @@
 package readarr_test
 
 import (
+	"encoding/json"
 	"net/http"
 	"path"
 	"testing"
@@ -216,6 +217,7 @@ var testEdition = readarr.Edition{
 	Isbn13:           "123456789012",
 	Asin:             "123456789X",
 	Title:            "Book",
+	Language:         "eng",
 	Overview:         "book overview",
 	Format:           "Hardcover",
 	IsEbook:          false,
@@ -264,6 +266,7 @@ var testAuthor = readarr.Author{
 	Ratings:             &testAuthorRating,
 	Statistics:          &testStatistics,
 	ID:                  123,
+	Unknown:             starr.UnknownFields{"folder": json.RawMessage(`"Firstname Lastname"`)},
 }
 
 var testSearchStruct = readarr.SearchResult{
@@ -296,6 +299,7 @@ var testSearchStruct = readarr.SearchResult{
 		Editions:    []*readarr.Edition{&testEdition},
 		Grabbed:     false,
 		ID:          123,
+		Unknown:     starr.UnknownFields{"foreignEditionId": json.RawMessage(`"123123"`)},
 	},
 	Author: nil,
 }
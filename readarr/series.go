@@ -0,0 +1,62 @@
+package readarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"golift.io/starr"
+)
+
+const bpSeries = APIver + "/series"
+
+// Series is a series of books, from the /api/v1/series endpoint.
+type Series struct {
+	ID          int64             `json:"id"`
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Links       []*SeriesBookLink `json:"links"`
+}
+
+// SeriesBookLink places a book in a series.
+type SeriesBookLink struct {
+	ID             int64  `json:"id"`
+	Position       string `json:"position"`
+	SeriesPosition int    `json:"seriesPosition"`
+	SeriesID       int64  `json:"seriesId"`
+	BookID         int64  `json:"bookId"`
+}
+
+// GetSeries returns book series without an author filter.
+// Some Readarr versions only return series for an author; use GetSeriesByAuthor with those.
+func (r *Readarr) GetSeries() ([]*Series, error) {
+	return r.GetSeriesContext(context.Background())
+}
+
+// GetSeriesContext returns book series without an author filter.
+// Some Readarr versions only return series for an author; use GetSeriesByAuthorContext with those.
+func (r *Readarr) GetSeriesContext(ctx context.Context) ([]*Series, error) {
+	return r.getSeries(ctx, starr.Request{URI: bpSeries})
+}
+
+// GetSeriesByAuthor returns the series an author's books belong to.
+func (r *Readarr) GetSeriesByAuthor(authorID int64) ([]*Series, error) {
+	return r.GetSeriesByAuthorContext(context.Background(), authorID)
+}
+
+// GetSeriesByAuthorContext returns the series an author's books belong to.
+func (r *Readarr) GetSeriesByAuthorContext(ctx context.Context, authorID int64) ([]*Series, error) {
+	req := starr.Request{URI: bpSeries, Query: make(url.Values)}
+	req.Query.Set("authorId", starr.Str(authorID))
+
+	return r.getSeries(ctx, req)
+}
+
+func (r *Readarr) getSeries(ctx context.Context, req starr.Request) ([]*Series, error) {
+	var output []*Series
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,84 @@
+package readarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/readarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestGetSeriesByAuthor(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "series?authorId=3"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			WithRequest:    int64(3),
+			ResponseBody: `[{"id": 1, "title": "Discworld", "description": "",
+				"links": [{"id": 5, "position": "1", "seriesPosition": 1, "seriesId": 1, "bookId": 9}]}]`,
+			WithResponse: []*readarr.Series{{
+				ID:    1,
+				Title: "Discworld",
+				Links: []*readarr.SeriesBookLink{{ID: 5, Position: "1", SeriesPosition: 1, SeriesID: 1, BookID: 9}},
+			}},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "series?authorId=3"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			WithRequest:    int64(3),
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   []*readarr.Series(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetSeriesByAuthor(test.WithRequest.(int64))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGetEditions(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "edition?bookId=9&bookId=10"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			WithRequest:    []int64{9, 10},
+			ResponseBody:   `[{"id": 2, "bookId": 9, "title": "Guards! Guards!", "isbn13": "9780552166669", "monitored": true}]`,
+			WithResponse: []*readarr.Edition{{
+				ID: 2, BookID: 9, Title: "Guards! Guards!", Isbn13: "9780552166669", Monitored: true,
+			}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetEditions(test.WithRequest.([]int64)...)
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package readarr_test
+
+import (
+	"testing"
+
+	"golift.io/starr/starrtest"
+)
+
+func TestSpecDrift(t *testing.T) {
+	t.Parallel()
+	starrtest.ReportSpecDrift(t, "readarr", nil)
+}
@@ -69,13 +69,13 @@ func (r *Readarr) GetSystemStatusContext(ctx context.Context) (*SystemStatus, er
 }
 
 // GetBackupFiles returns all available Readarr backup files.
-// Use Get() to download a file using BackupFile.Path.
+// Use starr.Config.DownloadBackup to download one of the files.
 func (r *Readarr) GetBackupFiles() ([]*starr.BackupFile, error) {
 	return r.GetBackupFilesContext(context.Background())
 }
 
 // GetBackupFilesContext returns all available Readarr backup files.
-// Use Get() to download a file using BackupFile.Path.
+// Use starr.Config.DownloadBackup to download one of the files.
 func (r *Readarr) GetBackupFilesContext(ctx context.Context) ([]*starr.BackupFile, error) {
 	var output []*starr.BackupFile
 
@@ -3,6 +3,7 @@ package readarr
 import (
 	"context"
 	"fmt"
+	"iter"
 	"path"
 
 	"golift.io/starr"
@@ -48,6 +49,19 @@ func (r *Readarr) GetWantedMissingPageContext(ctx context.Context, params *starr
 	return &output, nil
 }
 
+// WantedMissingAll returns an iterator over every missing book from Readarr.
+// Pages are fetched lazily as the loop runs, and the loop may break early. See starr.Paginate for more.
+func (r *Readarr) WantedMissingAll(ctx context.Context, params *starr.PageReq) iter.Seq2[*Book, error] {
+	return starr.Paginate(ctx, params, func(ctx context.Context, req *starr.PageReq) ([]*Book, int, error) {
+		page, err := r.GetWantedMissingPageContext(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Records, page.TotalRecords, nil
+	})
+}
+
 // GetWantedCutoffPage returns a page of books past quality cutoff.
 func (r *Readarr) GetWantedCutoffPage(params *starr.PageReq) (*WantedBooksPage, error) {
 	return r.GetWantedCutoffPageContext(context.Background(), params)
@@ -67,3 +81,16 @@ func (r *Readarr) GetWantedCutoffPageContext(ctx context.Context, params *starr.
 
 	return &output, nil
 }
+
+// WantedCutoffAll returns an iterator over every book past quality cutoff from Readarr.
+// Pages are fetched lazily as the loop runs, and the loop may break early. See starr.Paginate for more.
+func (r *Readarr) WantedCutoffAll(ctx context.Context, params *starr.PageReq) iter.Seq2[*Book, error] {
+	return starr.Paginate(ctx, params, func(ctx context.Context, req *starr.PageReq) ([]*Book, int, error) {
+		page, err := r.GetWantedCutoffPageContext(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Records, page.TotalRecords, nil
+	})
+}
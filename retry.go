@@ -0,0 +1,225 @@
+package starr
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+/* This file contains the optional retry and backoff logic used by Config.req. */
+
+// Defaults for RetryPolicy. These are used when the matching member is zero.
+const (
+	DefaultRetryMinWait = 500 * time.Millisecond
+	DefaultRetryMaxWait = 30 * time.Second
+)
+
+// RetryPolicy controls how failed requests are retried. Add one to a starr.Config to enable retries.
+// Only idempotent methods (GET, HEAD, PUT, DELETE) are retried; a POST is always sent exactly once.
+// Requests are retried after temporary network errors and after any of the status codes in StatusCodes.
+// Temporary network errors are refused or reset connections, EOF, timeouts and the like.
+// TLS, certificate and URL errors are never retried.
+type RetryPolicy struct {
+	// Maximum number of attempts, including the first. Values less than 2 disable retries.
+	MaxAttempts int
+	// Wait this long before the first retry. Doubles for every attempt after. Default: 500ms.
+	MinWait time.Duration
+	// Never wait longer than this between attempts. Also caps Retry-After. Default: 30s.
+	MaxWait time.Duration
+	// Status codes that cause a retry. Default: 429, 502, 503, 504.
+	StatusCodes []int
+	// Disable random jitter on the backoff interval. Jitter is enabled by default.
+	NoJitter bool
+}
+
+// DefaultRetryStatusCodes are the status codes retried when RetryPolicy.StatusCodes is empty.
+//
+//nolint:gochecknoglobals // this is a read-only default.
+var DefaultRetryStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryError is returned when a request was retried after network errors and still failed.
+// Use errors.As to get the number of attempts; the last network error is wrapped.
+// A request that fails on its only attempt returns the network error without a RetryError,
+// and a failure with a status code is always returned as a *ReqError.
+type RetryError struct {
+	// Attempts is the number of requests made, including the first.
+	Attempts int
+	// Err is the error from the last attempt.
+	Err error
+}
+
+// Error returns the last network error and the number of attempts.
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("httpClient.Do(req) failed %d attempts: %v", e.Attempts, e.Err)
+}
+
+// Unwrap returns the error from the last attempt.
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// attempts returns the number of attempts allowed for an http method.
+func (p *RetryPolicy) attempts(method string) int {
+	if p == nil || p.MaxAttempts < 2 { //nolint:mnd
+		return 1
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return p.MaxAttempts
+	default:
+		return 1
+	}
+}
+
+// retryStatus returns true if the status code should be retried.
+func (p *RetryPolicy) retryStatus(code int) bool {
+	if len(p.StatusCodes) == 0 {
+		return slices.Contains(DefaultRetryStatusCodes, code)
+	}
+
+	return slices.Contains(p.StatusCodes, code)
+}
+
+// Backoff returns the duration to wait before the next attempt.
+// Attempt is the number of the attempt that just failed, starting at 1.
+// A positive retryAfter (from a Retry-After header) overrides the computed backoff, up to MaxWait.
+func (p *RetryPolicy) Backoff(attempt int, retryAfter time.Duration) time.Duration {
+	minWait, maxWait := p.MinWait, p.MaxWait
+	if minWait <= 0 {
+		minWait = DefaultRetryMinWait
+	}
+
+	if maxWait <= 0 {
+		maxWait = DefaultRetryMaxWait
+	}
+
+	if retryAfter > 0 {
+		return min(retryAfter, maxWait)
+	}
+
+	wait := minWait
+	for i := 1; i < attempt && wait < maxWait; i++ {
+		wait *= 2
+	}
+
+	wait = min(wait, maxWait)
+
+	if !p.NoJitter {
+		// Equal jitter: keep half the interval and randomize the other half.
+		wait = wait/2 + rand.N(wait/2+1) //nolint:gosec // not crypto.
+	}
+
+	return wait
+}
+
+// parseRetryAfter returns the duration from a Retry-After header.
+// The value may be a number of seconds or an HTTP date. Returns 0 if missing or invalid.
+func parseRetryAfter(header http.Header, now time.Time) time.Duration {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+
+	if secs, err := strconv.Atoi(value); err == nil && secs > 0 {
+		return time.Duration(secs) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+
+	return 0
+}
+
+// bufferBody reads a request body into memory so it can be sent more than once.
+func bufferBody(req *Request) error {
+	if req.Body == nil {
+		return nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return fmt.Errorf("reading request body: %w", err)
+	}
+
+	req.Body = bytes.NewReader(body)
+
+	return nil
+}
+
+// rewindBody returns the request body to the start before a retry.
+func rewindBody(req *Request) {
+	if seeker, ok := req.Body.(io.Seeker); ok {
+		_, _ = seeker.Seek(0, io.SeekStart)
+	}
+}
+
+// retryable returns true if an error from http.Client.Do is a temporary network error, worth trying again.
+func retryable(ctx context.Context, err error) bool {
+	switch {
+	case ctx.Err() != nil, errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return false
+	case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF),
+		errors.Is(err, syscall.ECONNRESET), errors.Is(err, syscall.ECONNREFUSED):
+		return true
+	case tlsError(err):
+		return false
+	}
+
+	// http.Client wraps every error in a *url.Error, and that is always a net.Error. Look inside it.
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		err = urlErr.Err
+	}
+
+	var netErr net.Error
+
+	return errors.As(err, &netErr)
+}
+
+// tlsError returns true if the error is from a TLS handshake or certificate check.
+// Some of these are net.Errors, but trying again does not fix them.
+func tlsError(err error) bool {
+	var (
+		recordErr    tls.RecordHeaderError
+		alertErr     tls.AlertError
+		verifyErr    *tls.CertificateVerificationError
+		authorityErr x509.UnknownAuthorityError
+		hostnameErr  x509.HostnameError
+		invalidErr   x509.CertificateInvalidError
+	)
+
+	return errors.As(err, &recordErr) || errors.As(err, &alertErr) || errors.As(err, &verifyErr) ||
+		errors.As(err, &authorityErr) || errors.As(err, &hostnameErr) || errors.As(err, &invalidErr)
+}
+
+// sleepCtx waits for the duration or until the context is cancelled.
+func sleepCtx(ctx context.Context, wait time.Duration) error {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err() //nolint:wrapcheck
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,176 @@
+package starr_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		assert.Equal(t, `{"some":"body"}`, string(body), "the body must be re-sent on every attempt")
+
+		if calls.Add(1) < 3 {
+			writer.Header().Set("Retry-After", "0")
+			writer.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		writer.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := starr.New("apiKey", server.URL, 0)
+	config.Retry = &starr.RetryPolicy{MaxAttempts: 3, MinWait: time.Millisecond}
+
+	resp, err := config.Put(context.Background(), starr.Request{URI: "/", Body: bytes.NewBufferString(`{"some":"body"}`)})
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, int32(3), calls.Load())
+
+	// POST is not idempotent, so it must not be retried.
+	calls.Store(0)
+
+	_, err = config.Post(context.Background(), starr.Request{URI: "/", Body: bytes.NewBufferString(`{"some":"body"}`)})
+
+	var reqErr *starr.ReqError
+
+	require.ErrorAs(t, err, &reqErr)
+	assert.Equal(t, 1, reqErr.Attempts)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestRetryPolicyAttempts(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		writer.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	config := starr.New("apiKey", server.URL, 0)
+	config.Retry = &starr.RetryPolicy{MaxAttempts: 4, MinWait: time.Millisecond, NoJitter: true}
+
+	_, err := config.Get(context.Background(), starr.Request{URI: "/"})
+
+	var reqErr *starr.ReqError
+
+	require.ErrorAs(t, err, &reqErr)
+	require.ErrorIs(t, err, starr.ErrInvalidStatusCode)
+	assert.Equal(t, 4, reqErr.Attempts)
+	assert.Equal(t, int32(4), calls.Load())
+	assert.Equal(t, "invalid status code, 502 >= 300 (4 attempts)", reqErr.Error())
+
+	// Not-retryable codes return right away.
+	calls.Store(0)
+
+	config.Retry.StatusCodes = []int{http.StatusInternalServerError}
+	_, err = config.Get(context.Background(), starr.Request{URI: "/"})
+	require.ErrorAs(t, err, &reqErr)
+	assert.Equal(t, 1, reqErr.Attempts)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+// failTransport fails every request with the same error, and counts them.
+type failTransport struct {
+	err   error
+	calls atomic.Int32
+}
+
+func (f *failTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	f.calls.Add(1)
+	return nil, f.err
+}
+
+func TestRetryPolicyNetworkErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		err   error
+		retry bool
+	}{
+		{name: "eof", err: io.EOF, retry: true},
+		{name: "unexpected eof", err: io.ErrUnexpectedEOF, retry: true},
+		{name: "reset", err: &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}, retry: true},
+		{name: "refused", err: &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}, retry: true},
+		{name: "dns", err: &net.DNSError{Err: "server misbehaving", IsTemporary: true}, retry: true},
+		{name: "tls record", err: tls.RecordHeaderError{Msg: "first record does not look like a TLS handshake"}},
+		{name: "tls alert", err: &net.OpError{Op: "remote error", Err: tls.AlertError(42)}},
+		{name: "x509", err: &tls.CertificateVerificationError{Err: x509.UnknownAuthorityError{}}},
+		{name: "hostname", err: x509.HostnameError{Host: "starr"}},
+		{name: "other", err: errors.New("unsupported protocol scheme")}, //nolint:err113
+		{name: "canceled", err: context.Canceled},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			transport := &failTransport{err: test.err}
+			config := starr.New("apiKey", "http://starr", 0)
+			config.Client = &http.Client{Transport: transport}
+			config.Retry = &starr.RetryPolicy{MaxAttempts: 3, MinWait: time.Millisecond}
+
+			_, err := config.Get(context.Background(), starr.Request{URI: "/"})
+			require.ErrorIs(t, err, test.err, "the network error must be wrapped")
+
+			var retryErr *starr.RetryError
+
+			if !test.retry {
+				assert.Equal(t, int32(1), transport.calls.Load())
+				assert.NotErrorAs(t, err, &retryErr, "one attempt must not return a RetryError")
+
+				return
+			}
+
+			assert.Equal(t, int32(3), transport.calls.Load())
+			require.ErrorAs(t, err, &retryErr)
+			assert.Equal(t, 3, retryErr.Attempts)
+			assert.Contains(t, err.Error(), "failed 3 attempts")
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	t.Parallel()
+
+	policy := &starr.RetryPolicy{MinWait: time.Second, MaxWait: 5 * time.Second, NoJitter: true}
+	assert.Equal(t, time.Second, policy.Backoff(1, 0))
+	assert.Equal(t, 2*time.Second, policy.Backoff(2, 0))
+	assert.Equal(t, 4*time.Second, policy.Backoff(3, 0))
+	assert.Equal(t, 5*time.Second, policy.Backoff(4, 0), "backoff must not exceed MaxWait")
+	assert.Equal(t, 3*time.Second, policy.Backoff(1, 3*time.Second), "Retry-After must be honored")
+	assert.Equal(t, 5*time.Second, policy.Backoff(1, time.Minute), "Retry-After must not exceed MaxWait")
+
+	policy.NoJitter = false
+
+	for range 10 {
+		wait := policy.Backoff(3, 0)
+		assert.GreaterOrEqual(t, wait, 2*time.Second)
+		assert.LessOrEqual(t, wait, 4*time.Second)
+	}
+}
@@ -297,3 +297,44 @@ type BulkIndexer struct {
 	EnableInteractiveSearch *bool     `json:"enableInteractiveSearch,omitempty"`
 	Priority                *int64    `json:"priority,omitempty"`
 }
+
+// BulkDownloadClient is the input to UpdateDownloadClients on all apps.
+// Use the starr.True/False/Ptr() funcs to create the pointers.
+// Prowlarr does not have RemoveCompletedDownloads or RemoveFailedDownloads.
+type BulkDownloadClient struct {
+	IDs                      []int64   `json:"ids"`
+	Tags                     []int     `json:"tags,omitempty"`
+	ApplyTags                ApplyTags `json:"applyTags,omitempty"`
+	Enable                   *bool     `json:"enable,omitempty"`
+	Priority                 *int64    `json:"priority,omitempty"`
+	RemoveCompletedDownloads *bool     `json:"removeCompletedDownloads,omitempty"`
+	RemoveFailedDownloads    *bool     `json:"removeFailedDownloads,omitempty"`
+}
+
+// BulkImportList is the input to UpdateImportLists on all apps except Prowlarr.
+// Use the starr.True/False/Ptr() funcs to create the pointers.
+// Radarr uses Enabled, EnableAuto and MinimumAvailability instead of EnableAutomaticAdd.
+// MetadataProfileID is only in Readarr.
+type BulkImportList struct {
+	IDs                 []int64   `json:"ids"`
+	Tags                []int     `json:"tags,omitempty"`
+	ApplyTags           ApplyTags `json:"applyTags,omitempty"`
+	EnableAutomaticAdd  *bool     `json:"enableAutomaticAdd,omitempty"`
+	Enabled             *bool     `json:"enabled,omitempty"`
+	EnableAuto          *bool     `json:"enableAuto,omitempty"`
+	RootFolderPath      string    `json:"rootFolderPath,omitempty"`
+	QualityProfileID    *int64    `json:"qualityProfileId,omitempty"`
+	MetadataProfileID   *int64    `json:"metadataProfileId,omitempty"`
+	MinimumAvailability string    `json:"minimumAvailability,omitempty"`
+}
+
+// BulkCustomFormat is the input to UpdateCustomFormats in Sonarr, Radarr and Lidarr.
+type BulkCustomFormat struct {
+	IDs                             []int64 `json:"ids"`
+	IncludeCustomFormatWhenRenaming *bool   `json:"includeCustomFormatWhenRenaming,omitempty"`
+}
+
+// BulkDelete is the input to the bulk delete methods, like DeleteDownloadClients, on all apps.
+type BulkDelete struct {
+	IDs []int64 `json:"ids"`
+}
@@ -0,0 +1,108 @@
+package sonarr_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/debuglog"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrtest"
+)
+
+// TestCassette records a few requests against the fake server, and replays them without it.
+func TestCassette(t *testing.T) {
+	t.Parallel()
+
+	const secret = "hunter22"
+
+	fake := starrtest.NewFakeServer(t, starr.Sonarr, "")
+	config := starr.New(fake.APIKey, fake.URL, 0)
+	recorder := debuglog.NewRecordingRoundTripper(debuglog.Config{Redact: []string{secret}}, config.Client.Transport)
+	config.Client.Transport = recorder
+	client := sonarr.New(config)
+
+	_, err := client.AddTag(&starr.Tag{Label: secret})
+	require.NoError(t, err)
+
+	tags, err := client.GetTags()
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+
+	cassetteFile := filepath.Join(t.TempDir(), "sonarr.json")
+	require.NoError(t, recorder.Save(cassetteFile))
+
+	data, err := os.ReadFile(cassetteFile)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), fake.APIKey, "the api key must be scrubbed")
+	assert.NotContains(t, string(data), secret, "redacted values must be scrubbed")
+
+	replay, err := starrtest.NewReplayRoundTripper(cassetteFile)
+	require.NoError(t, err)
+
+	client = sonarr.New(&starr.Config{URL: "http://replay.invalid/", APIKey: "other", Client: replay.Client()})
+
+	_, err = client.AddTag(&starr.Tag{Label: secret})
+	require.NoError(t, err)
+
+	tags, err = client.GetTags()
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	assert.Equal(t, "<redacted>", tags[0].Label)
+	replay.AssertDone(t)
+
+	// Every interaction is served once.
+	_, err = client.GetTags()
+	require.ErrorIs(t, err, starrtest.ErrNoInteraction)
+	assert.Equal(t, 0, replay.Remaining())
+}
+
+// TestCassetteScrubsEchoedKey makes sure the API key and other secrets in response bodies are not saved.
+func TestCassetteScrubsEchoedKey(t *testing.T) {
+	t.Parallel()
+
+	const apiKey = "0123456789abcdef0123456789abcdef"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/config/host":
+			_, _ = w.Write([]byte(`{"id": 1, "apiKey": "` + r.Header.Get("X-Api-Key") + `", "password": "letmein"}`))
+		default:
+			_, _ = w.Write([]byte(`[{"id": 2, "name": "qbit", "fields": [
+				{"name": "host", "value": "localhost"},
+				{"name": "password", "value": "qbitpass", "privacy": "password"},
+				{"name": "authToken", "value": "tokenvalue"}]}]`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	config := starr.New(apiKey, server.URL, 0)
+	recorder := debuglog.NewRecordingRoundTripper(debuglog.Config{}, config.Client.Transport)
+	config.Client.Transport = recorder
+	client := sonarr.New(config)
+
+	host, err := client.GetHostConfig()
+	require.NoError(t, err)
+	assert.Equal(t, apiKey, host.APIKey, "the caller still gets the real response")
+
+	clients, err := client.GetDownloadClients()
+	require.NoError(t, err)
+	require.Len(t, clients, 1)
+
+	cassetteFile := filepath.Join(t.TempDir(), "sonarr.json")
+	require.NoError(t, recorder.Save(cassetteFile))
+
+	data, err := os.ReadFile(cassetteFile)
+	require.NoError(t, err)
+
+	for _, secret := range []string{apiKey, "letmein", "qbitpass", "tokenvalue"} {
+		assert.NotContains(t, string(data), secret, "secrets in bodies must be scrubbed")
+	}
+
+	assert.Contains(t, string(data), "localhost", "other fields are kept")
+}
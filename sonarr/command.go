@@ -14,15 +14,18 @@ import (
 const bpCommand = APIver + "/command"
 
 // CommandRequest goes into the /api/v3/command endpoint.
-// This was created from the search command and may not support other commands yet.
+// Create one with a command function, like RefreshSeriesCommand, and pass it to SendCommand.
 type CommandRequest struct {
-	SeasonNumber int     `json:"seasonNumber,omitempty"`
-	SeriesID     int64   `json:"seriesId,omitempty"`
-	EpisodeID    int64   `json:"episodeId,omitempty"`
-	Name         string  `json:"name"`
-	Files        []int64 `json:"files,omitempty"` // RenameFiles only
-	SeriesIDs    []int64 `json:"seriesIds,omitempty"`
-	EpisodeIDs   []int64 `json:"episodeIds,omitempty"`
+	SeasonNumber     int     `json:"seasonNumber,omitempty"`
+	SeriesID         int64   `json:"seriesId,omitempty"`
+	EpisodeID        int64   `json:"episodeId,omitempty"`
+	Name             string  `json:"name"`
+	Files            []int64 `json:"files,omitempty"` // RenameFiles only
+	SeriesIDs        []int64 `json:"seriesIds,omitempty"`
+	EpisodeIDs       []int64 `json:"episodeIds,omitempty"`
+	Path             string  `json:"path,omitempty"`             // DownloadedEpisodesScan only
+	DownloadClientID string  `json:"downloadClientId,omitempty"` // DownloadedEpisodesScan only
+	ImportMode       string  `json:"importMode,omitempty"`       // DownloadedEpisodesScan only
 }
 
 // CommandResponse comes from the /api/v3/command endpoint.
@@ -110,3 +113,34 @@ func (s *Sonarr) GetCommandStatusContext(ctx context.Context, commandID int64) (
 
 	return &output, nil
 }
+
+// CommandResult is returned by WaitForCommand and SendCommandAndWait.
+type CommandResult = starr.CommandResult[CommandResponse]
+
+// WaitForCommand polls a command, with backoff, until it completes, fails or is aborted.
+// A failed command is not an error; check the Status. If the context is cancelled,
+// the last known status is returned with the context error.
+func (s *Sonarr) WaitForCommand(ctx context.Context, commandID int64) (*CommandResult, error) {
+	return starr.WaitForCommand(ctx, func(ctx context.Context) (*CommandResponse, starr.CommandStatus, error) {
+		output, err := s.GetCommandStatusContext(ctx, commandID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, starr.CommandStatus(output.Status), nil
+	})
+}
+
+// SendCommandAndWait sends a command to Sonarr and waits for it to finish. See WaitForCommand.
+func (s *Sonarr) SendCommandAndWait(ctx context.Context, cmd *CommandRequest) (*CommandResult, error) {
+	output, err := s.SendCommandContext(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	if output.ID == 0 {
+		return &CommandResult{Command: output, Status: starr.CommandStatus(output.Status)}, nil
+	}
+
+	return s.WaitForCommand(ctx, output.ID)
+}
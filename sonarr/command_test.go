@@ -1,6 +1,7 @@
 package sonarr_test
 
 import (
+	"encoding/json"
 	"net/http"
 	"path"
 	"testing"
@@ -218,3 +219,43 @@ func TestGetCommandStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestSendCommandAndWait(t *testing.T) {
+	t.Parallel()
+
+	fake := starrtest.NewFakeServer(t, starr.Sonarr, "")
+	client := sonarr.New(starr.New(fake.APIKey, fake.URL, 0))
+
+	result, err := client.SendCommandAndWait(t.Context(), &sonarr.CommandRequest{Name: "RssSync"})
+	require.NoError(t, err)
+	assert.Equal(t, starr.CommandCompleted, result.Status)
+	assert.Equal(t, "RssSync", result.Command.Name)
+	assert.Positive(t, result.Duration)
+
+	result, err = client.SendCommandAndWait(t.Context(), nil)
+	require.NoError(t, err, "a nil command is not sent")
+	assert.True(t, result.Status.Done())
+}
+
+func TestCommandConstructors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		cmd      *sonarr.CommandRequest
+		expected string
+	}{
+		{sonarr.RefreshSeriesCommand(1, 2), `{"name":"RefreshSeries","seriesIds":[1,2]}`},
+		{sonarr.SeasonSearchCommand(3, 2), `{"seasonNumber":2,"seriesId":3,"name":"SeasonSearch"}`},
+		{sonarr.EpisodeSearchCommand(7), `{"name":"EpisodeSearch","episodeIds":[7]}`},
+		{sonarr.RenameFilesCommand(3, 10, 11), `{"seriesId":3,"name":"RenameFiles","files":[10,11]}`},
+		{sonarr.DownloadedEpisodesScanCommand("/downloads/show", "abc", sonarr.ImportModeMove), `{"name":"DownloadedEpisodesScan","path":"/downloads/show","downloadClientId":"abc","importMode":"move"}`},
+		{sonarr.RssSyncCommand(), `{"name":"RssSync"}`},
+		{sonarr.ApplicationCheckUpdateCommand(), `{"name":"ApplicationCheckUpdate"}`},
+	}
+
+	for _, test := range tests {
+		output, err := json.Marshal(test.cmd)
+		require.NoError(t, err)
+		assert.JSONEq(t, test.expected, string(output), test.cmd.Name)
+	}
+}
@@ -0,0 +1,119 @@
+package sonarr
+
+/* This file contains a constructor for each command Sonarr accepts on the /command endpoint.
+ * The names and bodies come from the *Command classes in the Sonarr source code.
+ * Pass the output of any of these to SendCommand or SendCommandAndWait.
+ */
+
+// Import modes for DownloadedEpisodesScanCommand.
+const (
+	ImportModeAuto = "auto"
+	ImportModeMove = "move"
+	ImportModeCopy = "copy"
+)
+
+// RefreshSeriesCommand refreshes metadata for series, and rescans their files. No IDs refreshes every series.
+func RefreshSeriesCommand(seriesIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "RefreshSeries", SeriesIDs: seriesIDs}
+}
+
+// RescanSeriesCommand rescans the files for a series. A zero ID rescans every series.
+func RescanSeriesCommand(seriesID int64) *CommandRequest {
+	return &CommandRequest{Name: "RescanSeries", SeriesID: seriesID}
+}
+
+// SeriesSearchCommand searches for every missing monitored episode in a series.
+func SeriesSearchCommand(seriesID int64) *CommandRequest {
+	return &CommandRequest{Name: "SeriesSearch", SeriesID: seriesID}
+}
+
+// SeasonSearchCommand searches for every missing monitored episode in a season.
+func SeasonSearchCommand(seriesID int64, seasonNumber int) *CommandRequest {
+	return &CommandRequest{Name: "SeasonSearch", SeriesID: seriesID, SeasonNumber: seasonNumber}
+}
+
+// EpisodeSearchCommand searches for specific episodes.
+func EpisodeSearchCommand(episodeIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "EpisodeSearch", EpisodeIDs: episodeIDs}
+}
+
+// MissingEpisodeSearchCommand searches for every missing monitored episode.
+func MissingEpisodeSearchCommand() *CommandRequest {
+	return &CommandRequest{Name: "MissingEpisodeSearch"}
+}
+
+// CutoffUnmetEpisodeSearchCommand searches for upgrades to every episode that has not met its cutoff.
+func CutoffUnmetEpisodeSearchCommand() *CommandRequest {
+	return &CommandRequest{Name: "CutoffUnmetEpisodeSearch"}
+}
+
+// RenameFilesCommand renames episode files in a series. Get the file IDs from GetSeriesRenames.
+func RenameFilesCommand(seriesID int64, fileIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "RenameFiles", SeriesID: seriesID, Files: fileIDs}
+}
+
+// RenameSeriesCommand renames every episode file in one or more series.
+func RenameSeriesCommand(seriesIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "RenameSeries", SeriesIDs: seriesIDs}
+}
+
+// DownloadedEpisodesScanCommand imports finished downloads from a path. All inputs are optional.
+// Without a path, every download client is checked. The import mode may be auto, move or copy.
+func DownloadedEpisodesScanCommand(path, downloadClientID, importMode string) *CommandRequest {
+	return &CommandRequest{
+		Name:             "DownloadedEpisodesScan",
+		Path:             path,
+		DownloadClientID: downloadClientID,
+		ImportMode:       importMode,
+	}
+}
+
+// RssSyncCommand checks every indexer's RSS feed for new releases.
+func RssSyncCommand() *CommandRequest {
+	return &CommandRequest{Name: "RssSync"}
+}
+
+// RefreshMonitoredDownloadsCommand checks download clients for finished downloads.
+func RefreshMonitoredDownloadsCommand() *CommandRequest {
+	return &CommandRequest{Name: "RefreshMonitoredDownloads"}
+}
+
+// ImportListSyncCommand syncs every import list.
+func ImportListSyncCommand() *CommandRequest {
+	return &CommandRequest{Name: "ImportListSync"}
+}
+
+// BackupCommand creates a manual backup. Find it with GetBackupFiles.
+func BackupCommand() *CommandRequest {
+	return &CommandRequest{Name: "Backup"}
+}
+
+// CheckHealthCommand runs every health check.
+func CheckHealthCommand() *CommandRequest {
+	return &CommandRequest{Name: "CheckHealth"}
+}
+
+// ApplicationCheckUpdateCommand checks for an application update.
+func ApplicationCheckUpdateCommand() *CommandRequest {
+	return &CommandRequest{Name: "ApplicationCheckUpdate"}
+}
+
+// ApplicationUpdateCommand installs an available application update.
+func ApplicationUpdateCommand() *CommandRequest {
+	return &CommandRequest{Name: "ApplicationUpdate"}
+}
+
+// ClearBlocklistCommand removes every item from the blocklist.
+func ClearBlocklistCommand() *CommandRequest {
+	return &CommandRequest{Name: "ClearBlocklist"}
+}
+
+// CleanUpRecycleBinCommand empties old files from the recycle bin.
+func CleanUpRecycleBinCommand() *CommandRequest {
+	return &CommandRequest{Name: "CleanUpRecycleBin"}
+}
+
+// HousekeepingCommand runs the housekeeping (database cleanup) tasks.
+func HousekeepingCommand() *CommandRequest {
+	return &CommandRequest{Name: "Housekeeping"}
+}
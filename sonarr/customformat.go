@@ -160,3 +160,51 @@ func (s *Sonarr) DeleteCustomFormatContext(ctx context.Context, formatID int64)
 
 	return nil
 }
+
+// UpdateCustomFormats bulk updates custom formats.
+func (s *Sonarr) UpdateCustomFormats(bulk *starr.BulkCustomFormat) ([]*CustomFormatOutput, error) {
+	return s.UpdateCustomFormatsContext(context.Background(), bulk)
+}
+
+// UpdateCustomFormatsContext bulk updates custom formats.
+func (s *Sonarr) UpdateCustomFormatsContext(
+	ctx context.Context, bulk *starr.BulkCustomFormat,
+) ([]*CustomFormatOutput, error) {
+	var (
+		output []*CustomFormatOutput
+		body   bytes.Buffer
+	)
+
+	if err := json.NewEncoder(&body).Encode(bulk); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpCustomFormat, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpCustomFormat, "bulk"), Body: &body}
+	if err := s.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteCustomFormats bulk deletes custom formats by their IDs.
+func (s *Sonarr) DeleteCustomFormats(ids []int64) error {
+	return s.DeleteCustomFormatsContext(context.Background(), ids)
+}
+
+// DeleteCustomFormatsContext bulk deletes custom formats by their IDs.
+func (s *Sonarr) DeleteCustomFormatsContext(ctx context.Context, ids []int64) error {
+	input := starr.BulkDelete{IDs: ids}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpCustomFormat, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpCustomFormat, "bulk"), Body: &body}
+	if err := s.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"path"
 
 	"golift.io/starr"
+	"golift.io/starr/starrshared"
 )
 
 // Define Base Path for download client calls.
@@ -172,3 +173,115 @@ func (s *Sonarr) DeleteDownloadClientContext(ctx context.Context, downloadclient
 
 	return nil
 }
+
+// UpdateDownloadClients bulk updates download clients.
+// Use it to enable, re-tag or re-prioritize many clients at once.
+func (s *Sonarr) UpdateDownloadClients(bulk *starr.BulkDownloadClient) ([]*DownloadClientOutput, error) {
+	return s.UpdateDownloadClientsContext(context.Background(), bulk)
+}
+
+// UpdateDownloadClientsContext bulk updates download clients.
+// Use it to enable, re-tag or re-prioritize many clients at once.
+func (s *Sonarr) UpdateDownloadClientsContext(
+	ctx context.Context, bulk *starr.BulkDownloadClient,
+) ([]*DownloadClientOutput, error) {
+	var (
+		output []*DownloadClientOutput
+		body   bytes.Buffer
+	)
+
+	if err := json.NewEncoder(&body).Encode(bulk); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpDownloadClient, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpDownloadClient, "bulk"), Body: &body}
+	if err := s.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteDownloadClients bulk deletes download clients by their IDs.
+func (s *Sonarr) DeleteDownloadClients(ids []int64) error {
+	return s.DeleteDownloadClientsContext(context.Background(), ids)
+}
+
+// DeleteDownloadClientsContext bulk deletes download clients by their IDs.
+func (s *Sonarr) DeleteDownloadClientsContext(ctx context.Context, ids []int64) error {
+	input := starr.BulkDelete{IDs: ids}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpDownloadClient, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpDownloadClient, "bulk"), Body: &body}
+	if err := s.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// GetDownloadClientSchema returns a template for every download client implementation the app has.
+func (s *Sonarr) GetDownloadClientSchema() ([]*DownloadClientOutput, error) {
+	return s.GetDownloadClientSchemaContext(context.Background())
+}
+
+// GetDownloadClientSchemaContext returns a template for every download client implementation the app has.
+func (s *Sonarr) GetDownloadClientSchemaContext(ctx context.Context) ([]*DownloadClientOutput, error) {
+	var output []*DownloadClientOutput
+
+	req := starr.Request{URI: path.Join(bpDownloadClient, "schema")}
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// TestAllDownloadClients tests every download client.
+// Validation failures are returned in the results, not as an error.
+func (s *Sonarr) TestAllDownloadClients() ([]*ProviderTestResult, error) {
+	return s.TestAllDownloadClientsContext(context.Background())
+}
+
+// TestAllDownloadClientsContext tests every download client.
+// Validation failures are returned in the results, not as an error.
+func (s *Sonarr) TestAllDownloadClientsContext(ctx context.Context) ([]*ProviderTestResult, error) {
+	var output []*ProviderTestResult
+
+	req := starr.Request{URI: path.Join(bpDownloadClient, "testall")}
+	if err := s.PostInto(ctx, req, &output); err != nil && !starrshared.TestResultsFromError(err, &output) {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DownloadClientAction runs a named action on a download client, like the ones its settings form uses
+// to fill in options. The reply depends on the action, so it is returned as raw JSON.
+func (s *Sonarr) DownloadClientAction(name string, client *DownloadClientInput) (json.RawMessage, error) {
+	return s.DownloadClientActionContext(context.Background(), name, client)
+}
+
+// DownloadClientActionContext runs a named action on a download client, like the ones its settings form uses
+// to fill in options. The reply depends on the action, so it is returned as raw JSON.
+func (s *Sonarr) DownloadClientActionContext(
+	ctx context.Context, name string, client *DownloadClientInput,
+) (json.RawMessage, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(client); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpDownloadClient, err)
+	}
+
+	var output json.RawMessage
+
+	req := starr.Request{URI: path.Join(bpDownloadClient, "action", path.Base(name)), Body: &body}
+	if err := s.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
@@ -502,3 +502,88 @@ func TestDeleteDownloadClient(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateDownloadClients(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "202",
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "downloadClient", "bulk"),
+			ExpectedMethod:  "PUT",
+			ExpectedRequest: `{"ids":[3,4],"tags":[1],"applyTags":"add","enable":false}` + "\n",
+			WithRequest: &starr.BulkDownloadClient{
+				IDs:       []int64{3, 4},
+				Tags:      []int{1},
+				ApplyTags: starr.TagsAdd,
+				Enable:    starr.False(),
+			},
+			ResponseStatus: 202,
+			ResponseBody:   `[{"id": 3, "name": "one", "tags": [1]}, {"id": 4, "name": "two", "tags": [1]}]`,
+			WithResponse: []*sonarr.DownloadClientOutput{
+				{ID: 3, Name: "one", Tags: []int{1}},
+				{ID: 4, Name: "two", Tags: []int{1}},
+			},
+			WithError: nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "downloadClient", "bulk"),
+			ExpectedMethod:  "PUT",
+			ExpectedRequest: `{"ids":[3],"priority":10}` + "\n",
+			WithRequest:     &starr.BulkDownloadClient{IDs: []int64{3}, Priority: starr.Ptr(int64(10))},
+			ResponseStatus:  404,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:    []*sonarr.DownloadClientOutput(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.UpdateDownloadClients(test.WithRequest.(*starr.BulkDownloadClient))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.Equal(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestDeleteDownloadClients(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "downloadClient", "bulk"),
+			ExpectedMethod:  "DELETE",
+			ExpectedRequest: `{"ids":[3,4]}` + "\n",
+			WithRequest:     []int64{3, 4},
+			ResponseStatus:  200,
+			ResponseBody:    "{}",
+			WithError:       nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "downloadClient", "bulk"),
+			ExpectedMethod:  "DELETE",
+			ExpectedRequest: `{"ids":[3,4]}` + "\n",
+			WithRequest:     []int64{3, 4},
+			ResponseStatus:  404,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.DeleteDownloadClients(test.WithRequest.([]int64))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+		})
+	}
+}
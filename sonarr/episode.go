@@ -32,6 +32,24 @@ type Episode struct {
 	Monitored                bool           `json:"monitored"`
 	Images                   []*starr.Image `json:"images"`
 	Series                   *Series        `json:"series"`
+	// Unknown holds JSON fields this library does not model, so an update sends them back unchanged.
+	Unknown starr.UnknownFields `json:"-"`
+}
+
+// UnmarshalJSON decodes an episode, and saves any fields this library does not model in Unknown.
+func (e *Episode) UnmarshalJSON(data []byte) error {
+	type episode Episode
+
+	unknown, err := starr.DecodeUnknown(data, (*episode)(e))
+	e.Unknown = unknown
+
+	return err //nolint:wrapcheck // already wrapped.
+}
+
+// MarshalJSON encodes an episode, including any fields saved in Unknown.
+func (e Episode) MarshalJSON() ([]byte, error) {
+	type episode Episode
+	return starr.EncodeUnknown(episode(e), e.Unknown) //nolint:wrapcheck // already wrapped.
 }
 
 // GetEpisode represents the input parameters for an episode api request.
@@ -1,6 +1,7 @@
 package sonarr_test
 
 import (
+	"encoding/json"
 	"net/http"
 	"path"
 	"testing"
@@ -180,6 +181,17 @@ func TestGetEpisodeByID(t *testing.T) {
 	addedDate := time.Date(2023, 1, 17, 21, 14, 56, 0, loc)
 	firstAiredDate := time.Date(2023, 1, 15, 0, 0, 0, 0, loc)
 
+	// These fields are not modeled, so they must be preserved in Unknown.
+	var unknown struct {
+		EpisodeFile json.RawMessage `json:"episodeFile"`
+		Grabbed     json.RawMessage `json:"grabbed"`
+		Series      struct {
+			OriginalLanguage json.RawMessage `json:"originalLanguage"`
+		} `json:"series"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(episode), &unknown))
+
 	tests := []*starrtest.MockData{
 		{
 			Name:           "200",
@@ -207,7 +219,12 @@ func TestGetEpisodeByID(t *testing.T) {
 						RemoteURL: "https://artworks.thetvdb.com/banners/v4/episode/8444132/screencap/638bf7ef8ed12.jpg",
 					},
 				},
+				Unknown: starr.UnknownFields{
+					"episodeFile": unknown.EpisodeFile,
+					"grabbed":     unknown.Grabbed,
+				},
 				Series: &sonarr.Series{
+					Unknown:           starr.UnknownFields{"originalLanguage": unknown.Series.OriginalLanguage},
 					ID:                53,
 					Ended:             false,
 					Monitored:         true,
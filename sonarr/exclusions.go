@@ -82,6 +82,26 @@ func (s *Sonarr) DeleteExclusionsContext(ctx context.Context, ids []int64) error
 	return nil
 }
 
+// DeleteExclusionsBulk removes exclusions from Sonarr with one request.
+func (s *Sonarr) DeleteExclusionsBulk(ids []int64) error {
+	return s.DeleteExclusionsBulkContext(context.Background(), ids)
+}
+
+// DeleteExclusionsBulkContext removes exclusions from Sonarr with one request.
+func (s *Sonarr) DeleteExclusionsBulkContext(ctx context.Context, ids []int64) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(starr.BulkDelete{IDs: ids}); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpExclusions, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpExclusions, "bulk"), Body: &body}
+	if err := s.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
 // AddExclusion adds one exclusion to Sonarr.
 func (s *Sonarr) AddExclusion(exclusion *Exclusion) (*Exclusion, error) {
 	return s.AddExclusionContext(context.Background(), exclusion)
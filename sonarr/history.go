@@ -3,6 +3,7 @@ package sonarr
 import (
 	"context"
 	"fmt"
+	"iter"
 	"path"
 	"time"
 
@@ -120,6 +121,19 @@ func (s *Sonarr) GetHistoryPageContext(ctx context.Context, params *starr.PageRe
 	return &output, nil
 }
 
+// HistoryAll returns an iterator over every History record (grabs/failures/completed) from Sonarr.
+// Pages are fetched lazily as the loop runs, and the loop may break early. See starr.Paginate for more.
+func (s *Sonarr) HistoryAll(ctx context.Context, params *starr.PageReq) iter.Seq2[*HistoryRecord, error] {
+	return starr.Paginate(ctx, params, func(ctx context.Context, req *starr.PageReq) ([]*HistoryRecord, int, error) {
+		page, err := s.GetHistoryPageContext(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Records, page.TotalRecords, nil
+	})
+}
+
 // Fail marks the given history item as failed by id.
 func (s *Sonarr) Fail(historyID int64) error {
 	return s.FailContext(context.Background(), historyID)
@@ -9,6 +9,7 @@ import (
 	"path"
 
 	"golift.io/starr"
+	"golift.io/starr/starrshared"
 )
 
 const bpImportList = APIver + "/importList"
@@ -180,3 +181,115 @@ func (s *Sonarr) DeleteImportListContext(ctx context.Context, importListID int64
 
 	return nil
 }
+
+// UpdateImportLists bulk updates import lists.
+// Use it to enable, re-tag or move many lists at once.
+func (s *Sonarr) UpdateImportLists(bulk *starr.BulkImportList) ([]*ImportListOutput, error) {
+	return s.UpdateImportListsContext(context.Background(), bulk)
+}
+
+// UpdateImportListsContext bulk updates import lists.
+// Use it to enable, re-tag or move many lists at once.
+func (s *Sonarr) UpdateImportListsContext(
+	ctx context.Context, bulk *starr.BulkImportList,
+) ([]*ImportListOutput, error) {
+	var (
+		output []*ImportListOutput
+		body   bytes.Buffer
+	)
+
+	if err := json.NewEncoder(&body).Encode(bulk); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpImportList, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpImportList, "bulk"), Body: &body}
+	if err := s.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteImportLists bulk deletes import lists by their IDs.
+func (s *Sonarr) DeleteImportLists(ids []int64) error {
+	return s.DeleteImportListsContext(context.Background(), ids)
+}
+
+// DeleteImportListsContext bulk deletes import lists by their IDs.
+func (s *Sonarr) DeleteImportListsContext(ctx context.Context, ids []int64) error {
+	input := starr.BulkDelete{IDs: ids}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpImportList, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpImportList, "bulk"), Body: &body}
+	if err := s.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// GetImportListSchema returns a template for every import list implementation the app has.
+func (s *Sonarr) GetImportListSchema() ([]*ImportListOutput, error) {
+	return s.GetImportListSchemaContext(context.Background())
+}
+
+// GetImportListSchemaContext returns a template for every import list implementation the app has.
+func (s *Sonarr) GetImportListSchemaContext(ctx context.Context) ([]*ImportListOutput, error) {
+	var output []*ImportListOutput
+
+	req := starr.Request{URI: path.Join(bpImportList, "schema")}
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// TestAllImportLists tests every import list.
+// Validation failures are returned in the results, not as an error.
+func (s *Sonarr) TestAllImportLists() ([]*ProviderTestResult, error) {
+	return s.TestAllImportListsContext(context.Background())
+}
+
+// TestAllImportListsContext tests every import list.
+// Validation failures are returned in the results, not as an error.
+func (s *Sonarr) TestAllImportListsContext(ctx context.Context) ([]*ProviderTestResult, error) {
+	var output []*ProviderTestResult
+
+	req := starr.Request{URI: path.Join(bpImportList, "testall")}
+	if err := s.PostInto(ctx, req, &output); err != nil && !starrshared.TestResultsFromError(err, &output) {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// ImportListAction runs a named action on an import list, like the ones its settings form uses
+// to fill in options. The reply depends on the action, so it is returned as raw JSON.
+func (s *Sonarr) ImportListAction(name string, list *ImportListInput) (json.RawMessage, error) {
+	return s.ImportListActionContext(context.Background(), name, list)
+}
+
+// ImportListActionContext runs a named action on an import list, like the ones its settings form uses
+// to fill in options. The reply depends on the action, so it is returned as raw JSON.
+func (s *Sonarr) ImportListActionContext(
+	ctx context.Context, name string, list *ImportListInput,
+) (json.RawMessage, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(list); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpImportList, err)
+	}
+
+	var output json.RawMessage
+
+	req := starr.Request{URI: path.Join(bpImportList, "action", path.Base(name)), Body: &body}
+	if err := s.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
@@ -473,3 +473,96 @@ func TestDeleteIndexer(t *testing.T) {
 		})
 	}
 }
+
+func TestGetIndexerSchema(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "indexer", "schema"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			ResponseBody: `[{"implementation": "Newznab", "configContract": "NewznabSettings", "protocol": "usenet",
+				"fields": [{"name": "baseUrl"}, {"name": "apiPath", "value": "/api"}]}]`,
+			WithResponse: []*sonarr.IndexerOutput{{
+				Implementation: "Newznab",
+				ConfigContract: "NewznabSettings",
+				Protocol:       starr.ProtocolUsenet,
+				Fields:         []*starr.FieldOutput{{Name: "baseUrl"}, {Name: "apiPath", Value: "/api"}},
+			}},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "indexer", "schema"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   []*sonarr.IndexerOutput(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetIndexerSchema()
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.Equal(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestTestAllIndexers(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "indexer", "testall"),
+			ExpectedMethod: "POST",
+			ResponseStatus: 200,
+			ResponseBody:   `[{"id": 1, "isValid": true, "validationFailures": []}]`,
+			WithResponse:   []*sonarr.ProviderTestResult{{ID: 1, IsValid: true, ValidationFailures: starr.ValidationErrors{}}},
+			WithError:      nil,
+		},
+		{
+			Name:           "400 with results",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "indexer", "testall"),
+			ExpectedMethod: "POST",
+			ResponseStatus: 400,
+			ResponseBody: `[{"id": 1, "isValid": true, "validationFailures": []},
+				{"id": 2, "isValid": false, "validationFailures": [{"propertyName": "ApiKey", "errorMessage": "Invalid API Key"}]}]`,
+			WithResponse: []*sonarr.ProviderTestResult{
+				{ID: 1, IsValid: true, ValidationFailures: starr.ValidationErrors{}},
+				{ID: 2, IsValid: false, ValidationFailures: starr.ValidationErrors{
+					{PropertyName: "ApiKey", ErrorMessage: "Invalid API Key"},
+				}},
+			},
+			WithError: nil,
+		},
+		{
+			Name:           "400 with failures",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "indexer", "testall"),
+			ExpectedMethod: "POST",
+			ResponseStatus: 400,
+			ResponseBody:   `[{"propertyName": "", "errorMessage": "Something broke"}]`,
+			WithResponse:   []*sonarr.ProviderTestResult(nil),
+			WithError:      &starr.ReqError{Code: http.StatusBadRequest},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.TestAllIndexers()
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.Equal(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
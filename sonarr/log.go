@@ -3,42 +3,26 @@ package sonarr
 import (
 	"context"
 	"fmt"
+	"iter"
 	"path"
-	"time"
 
 	"golift.io/starr"
+	"golift.io/starr/starrshared"
 )
 
 const bpLog = APIver + "/log"
 
 // LogLine is one record from /api/v3/log.
-type LogLine struct {
-	ID            int       `json:"id"`
-	Time          time.Time `json:"time"`
-	Exception     string    `json:"exception,omitempty"`
-	ExceptionType string    `json:"exceptionType,omitempty"`
-	Level         string    `json:"level,omitempty"`
-	Logger        string    `json:"logger,omitempty"`
-	Message       string    `json:"message,omitempty"`
-	Method        string    `json:"method,omitempty"`
-}
+type LogLine = starrshared.LogLine
 
 // LogPage is a page of log lines from /api/v3/log.
-type LogPage struct {
-	Page          int        `json:"page"`
-	PageSize      int        `json:"pageSize"`
-	SortKey       string     `json:"sortKey"`
-	SortDirection string     `json:"sortDirection"`
-	TotalRecords  int        `json:"totalRecords"`
-	Records       []*LogLine `json:"records"`
-}
+type LogPage = starrshared.LogPage
 
 // LogFile describes a log file on disk.
-type LogFile struct {
-	Filename  string    `json:"filename,omitempty"`
-	Contents  string    `json:"contents,omitempty"`
-	LastWrite time.Time `json:"lastWrite,omitzero"`
-}
+type LogFile = starrshared.LogFile
+
+// LogTailOptions filter and pace the log lines from TailLogs.
+type LogTailOptions = starrshared.TailOptions
 
 // GetLogPage returns a page of application log lines.
 func (s *Sonarr) GetLogPage(params *starr.PageReq) (*LogPage, error) {
@@ -124,3 +108,10 @@ func (s *Sonarr) UpdateLogFileContext(ctx context.Context, filename string) ([]*
 
 	return output, nil
 }
+
+// TailLogs returns an iterator that polls the log and yields new lines, oldest first, as they are logged.
+// It runs until the context is cancelled or the loop breaks. Filter lines by level and logger in the options.
+// A failed poll is yielded with a nil line, and ends the loop. See starrshared.TailLogs for more.
+func (s *Sonarr) TailLogs(ctx context.Context, opts *LogTailOptions) iter.Seq2[*LogLine, error] {
+	return starrshared.TailLogs(ctx, s.GetLogPageContext, opts)
+}
@@ -9,6 +9,7 @@ import (
 	"path"
 
 	"golift.io/starr"
+	"golift.io/starr/starrshared"
 )
 
 const bpMetadata = APIver + "/metadata"
@@ -172,25 +173,44 @@ func (s *Sonarr) DeleteMetadataContext(ctx context.Context, id int64) error {
 }
 
 // MetadataAction runs a named action on a metadata consumer.
+//
+// Deprecated: Use MetadataActionResult, which also returns the reply.
 func (s *Sonarr) MetadataAction(name string, input *MetadataInput) error {
 	return s.MetadataActionContext(context.Background(), name, input)
 }
 
 // MetadataActionContext runs a named action on a metadata consumer.
+//
+// Deprecated: Use MetadataActionResultContext, which also returns the reply.
 func (s *Sonarr) MetadataActionContext(ctx context.Context, name string, input *MetadataInput) error {
+	_, err := s.MetadataActionResultContext(ctx, name, input)
+	return err
+}
+
+// MetadataActionResult runs a named action on a metadata consumer, like the ones its settings form uses
+// to fill in options. The reply depends on the action, so it is returned as raw JSON.
+func (s *Sonarr) MetadataActionResult(name string, input *MetadataInput) (json.RawMessage, error) {
+	return s.MetadataActionResultContext(context.Background(), name, input)
+}
+
+// MetadataActionResultContext runs a named action on a metadata consumer, like the ones its settings form
+// uses to fill in options. The reply depends on the action, so it is returned as raw JSON.
+func (s *Sonarr) MetadataActionResultContext(
+	ctx context.Context, name string, input *MetadataInput,
+) (json.RawMessage, error) {
 	var body bytes.Buffer
 	if err := json.NewEncoder(&body).Encode(input); err != nil {
-		return fmt.Errorf("json.Marshal(%s): %w", bpMetadata, err)
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpMetadata, err)
 	}
 
-	var output any
+	var output json.RawMessage
 
 	req := starr.Request{URI: path.Join(bpMetadata, "action", path.Base(name)), Body: &body}
 	if err := s.PostInto(ctx, req, &output); err != nil {
-		return fmt.Errorf("api.Post(%s): %w", &req, err)
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
 	}
 
-	return nil
+	return output, nil
 }
 
 // TestMetadata tests a metadata consumer configuration.
@@ -220,12 +240,16 @@ func (s *Sonarr) TestMetadataContext(ctx context.Context, input *MetadataInput,
 	return nil
 }
 
-// TestAllMetadata tests all metadata consumers.
+// TestAllMetadata tests all metadata consumers. Any failed test is returned as an error.
+//
+// Deprecated: Use TestAllMetadataResults, which returns the result for each consumer.
 func (s *Sonarr) TestAllMetadata() error {
 	return s.TestAllMetadataContext(context.Background())
 }
 
-// TestAllMetadataContext tests all metadata consumers.
+// TestAllMetadataContext tests all metadata consumers. Any failed test is returned as an error.
+//
+// Deprecated: Use TestAllMetadataResultsContext, which returns the result for each consumer.
 func (s *Sonarr) TestAllMetadataContext(ctx context.Context) error {
 	var output any
 
@@ -236,3 +260,22 @@ func (s *Sonarr) TestAllMetadataContext(ctx context.Context) error {
 
 	return nil
 }
+
+// TestAllMetadataResults tests all metadata consumers.
+// Validation failures are returned in the results, not as an error.
+func (s *Sonarr) TestAllMetadataResults() ([]*ProviderTestResult, error) {
+	return s.TestAllMetadataResultsContext(context.Background())
+}
+
+// TestAllMetadataResultsContext tests all metadata consumers.
+// Validation failures are returned in the results, not as an error.
+func (s *Sonarr) TestAllMetadataResultsContext(ctx context.Context) ([]*ProviderTestResult, error) {
+	var output []*ProviderTestResult
+
+	req := starr.Request{URI: path.Join(bpMetadata, "testall")}
+	if err := s.PostInto(ctx, req, &output); err != nil && !starrshared.TestResultsFromError(err, &output) {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,58 @@
+package sonarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrtest"
+)
+
+const metadataTestAllBody = `[{"id": 1, "isValid": true, "validationFailures": []},
+	{"id": 2, "isValid": false, "validationFailures": [{"propertyName": "Path", "errorMessage": "Not writable"}]}]`
+
+func TestTestAllMetadata(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		Name:           "400 with results",
+		ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "metadata", "testall"),
+		ExpectedMethod: "POST",
+		ResponseStatus: 400,
+		ResponseBody:   metadataTestAllBody,
+		WithError:      &starr.ReqError{Code: http.StatusBadRequest},
+	}
+
+	mockServer := test.GetMockServer(t)
+	client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+	err := client.TestAllMetadata() //nolint:staticcheck // testing the deprecated method.
+	require.ErrorIs(t, err, test.WithError, "a failed test is still an error")
+}
+
+func TestTestAllMetadataResults(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		Name:           "400 with results",
+		ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "metadata", "testall"),
+		ExpectedMethod: "POST",
+		ResponseStatus: 400,
+		ResponseBody:   metadataTestAllBody,
+		WithResponse: []*sonarr.ProviderTestResult{
+			{ID: 1, IsValid: true, ValidationFailures: starr.ValidationErrors{}},
+			{ID: 2, IsValid: false, ValidationFailures: starr.ValidationErrors{
+				{PropertyName: "Path", ErrorMessage: "Not writable"},
+			}},
+		},
+	}
+
+	mockServer := test.GetMockServer(t)
+	client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+	output, err := client.TestAllMetadataResults()
+	require.NoError(t, err)
+	assert.Equal(t, test.WithResponse, output, "response is not the same as expected")
+}
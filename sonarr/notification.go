@@ -8,6 +8,7 @@ import (
 	"path"
 
 	"golift.io/starr"
+	"golift.io/starr/starrshared"
 )
 
 // Define Base Path for notification calls.
@@ -158,3 +159,65 @@ func (s *Sonarr) DeleteNotificationContext(ctx context.Context, notificationID i
 
 	return nil
 }
+
+// GetNotificationSchema returns a template for every notification implementation the app has.
+func (s *Sonarr) GetNotificationSchema() ([]*NotificationOutput, error) {
+	return s.GetNotificationSchemaContext(context.Background())
+}
+
+// GetNotificationSchemaContext returns a template for every notification implementation the app has.
+func (s *Sonarr) GetNotificationSchemaContext(ctx context.Context) ([]*NotificationOutput, error) {
+	var output []*NotificationOutput
+
+	req := starr.Request{URI: path.Join(bpNotification, "schema")}
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// TestAllNotifications tests every notification.
+// Validation failures are returned in the results, not as an error.
+func (s *Sonarr) TestAllNotifications() ([]*ProviderTestResult, error) {
+	return s.TestAllNotificationsContext(context.Background())
+}
+
+// TestAllNotificationsContext tests every notification.
+// Validation failures are returned in the results, not as an error.
+func (s *Sonarr) TestAllNotificationsContext(ctx context.Context) ([]*ProviderTestResult, error) {
+	var output []*ProviderTestResult
+
+	req := starr.Request{URI: path.Join(bpNotification, "testall")}
+	if err := s.PostInto(ctx, req, &output); err != nil && !starrshared.TestResultsFromError(err, &output) {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// NotificationAction runs a named action on a notification, like the ones its settings form uses
+// to fill in options. The reply depends on the action, so it is returned as raw JSON.
+func (s *Sonarr) NotificationAction(name string, notification *NotificationInput) (json.RawMessage, error) {
+	return s.NotificationActionContext(context.Background(), name, notification)
+}
+
+// NotificationActionContext runs a named action on a notification, like the ones its settings form uses
+// to fill in options. The reply depends on the action, so it is returned as raw JSON.
+func (s *Sonarr) NotificationActionContext(
+	ctx context.Context, name string, notification *NotificationInput,
+) (json.RawMessage, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(notification); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpNotification, err)
+	}
+
+	var output json.RawMessage
+
+	req := starr.Request{URI: path.Join(bpNotification, "action", path.Base(name)), Body: &body}
+	if err := s.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
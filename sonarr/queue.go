@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"net/url"
 	"path"
 	"time"
@@ -125,6 +126,19 @@ func (s *Sonarr) GetQueuePageContext(ctx context.Context, params *starr.PageReq)
 	return &output, nil
 }
 
+// QueueAll returns an iterator over every Queue record (processing, but not yet imported) from Sonarr.
+// Pages are fetched lazily as the loop runs, and the loop may break early. See starr.Paginate for more.
+func (s *Sonarr) QueueAll(ctx context.Context, params *starr.PageReq) iter.Seq2[*QueueRecord, error] {
+	return starr.Paginate(ctx, params, func(ctx context.Context, req *starr.PageReq) ([]*QueueRecord, int, error) {
+		page, err := s.GetQueuePageContext(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Records, page.TotalRecords, nil
+	})
+}
+
 // DeleteQueue deletes an item from the Activity Queue.
 func (s *Sonarr) DeleteQueue(queueID int64, opts *starr.QueueDeleteOpts) error {
 	return s.DeleteQueueContext(context.Background(), queueID, opts)
@@ -77,6 +77,24 @@ type Series struct {
 	AlternateTitles   []*AlternateTitle `json:"alternateTitles,omitempty"`
 	Seasons           []*Season         `json:"seasons,omitempty"`
 	Images            []*starr.Image    `json:"images,omitempty"`
+	// Unknown holds JSON fields this library does not model, so an update sends them back unchanged.
+	Unknown starr.UnknownFields `json:"-"`
+}
+
+// UnmarshalJSON decodes a series, and saves any fields this library does not model in Unknown.
+func (s *Series) UnmarshalJSON(data []byte) error {
+	type series Series
+
+	unknown, err := starr.DecodeUnknown(data, (*series)(s))
+	s.Unknown = unknown
+
+	return err //nolint:wrapcheck // already wrapped.
+}
+
+// MarshalJSON encodes a series, including any fields saved in Unknown.
+func (s Series) MarshalJSON() ([]byte, error) {
+	type series Series
+	return starr.EncodeUnknown(series(s), s.Unknown) //nolint:wrapcheck // already wrapped.
 }
 
 // AddSeriesOptions is part of AddSeriesInput.
@@ -0,0 +1,12 @@
+package sonarr_test
+
+import (
+	"testing"
+
+	"golift.io/starr/starrtest"
+)
+
+func TestSpecDrift(t *testing.T) {
+	t.Parallel()
+	starrtest.ReportSpecDrift(t, "sonarr", nil)
+}
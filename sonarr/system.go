@@ -66,13 +66,13 @@ func (s *Sonarr) GetSystemStatusContext(ctx context.Context) (*SystemStatus, err
 }
 
 // GetBackupFiles returns all available Sonarr backup files.
-// Use Get to download a file using BackupFile.Path.
+// Use starr.Config.DownloadBackup to download one of the files.
 func (s *Sonarr) GetBackupFiles() ([]*starr.BackupFile, error) {
 	return s.GetBackupFilesContext(context.Background())
 }
 
 // GetBackupFilesContext returns all available Sonarr backup files.
-// Use Get() to download a file using BackupFile.Path.
+// Use starr.Config.DownloadBackup to download one of the files.
 func (s *Sonarr) GetBackupFilesContext(ctx context.Context) ([]*starr.BackupFile, error) {
 	var output []*starr.BackupFile
 
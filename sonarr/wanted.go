@@ -3,6 +3,7 @@ package sonarr
 import (
 	"context"
 	"fmt"
+	"iter"
 	"path"
 
 	"golift.io/starr"
@@ -48,6 +49,19 @@ func (s *Sonarr) GetWantedMissingPageContext(ctx context.Context, params *starr.
 	return &output, nil
 }
 
+// WantedMissingAll returns an iterator over every missing episode from Sonarr.
+// Pages are fetched lazily as the loop runs, and the loop may break early. See starr.Paginate for more.
+func (s *Sonarr) WantedMissingAll(ctx context.Context, params *starr.PageReq) iter.Seq2[*Episode, error] {
+	return starr.Paginate(ctx, params, func(ctx context.Context, req *starr.PageReq) ([]*Episode, int, error) {
+		page, err := s.GetWantedMissingPageContext(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Records, page.TotalRecords, nil
+	})
+}
+
 // GetWantedMissingEpisode returns a single missing episode by episode ID.
 func (s *Sonarr) GetWantedMissingEpisode(episodeID int64) (*Episode, error) {
 	return s.GetWantedMissingEpisodeContext(context.Background(), episodeID)
@@ -85,6 +99,19 @@ func (s *Sonarr) GetWantedCutoffPageContext(ctx context.Context, params *starr.P
 	return &output, nil
 }
 
+// WantedCutoffAll returns an iterator over every episode past quality cutoff from Sonarr.
+// Pages are fetched lazily as the loop runs, and the loop may break early. See starr.Paginate for more.
+func (s *Sonarr) WantedCutoffAll(ctx context.Context, params *starr.PageReq) iter.Seq2[*Episode, error] {
+	return starr.Paginate(ctx, params, func(ctx context.Context, req *starr.PageReq) ([]*Episode, int, error) {
+		page, err := s.GetWantedCutoffPageContext(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Records, page.TotalRecords, nil
+	})
+}
+
 // GetWantedCutoffEpisode returns a single cutoff-unmet episode by episode ID.
 func (s *Sonarr) GetWantedCutoffEpisode(episodeID int64) (*Episode, error) {
 	return s.GetWantedCutoffEpisodeContext(context.Background(), episodeID)
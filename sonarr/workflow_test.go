@@ -0,0 +1,82 @@
+package sonarr_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrtest"
+)
+
+// TestWorkflow adds a series, searches for it, and checks the queue using the stateful fake server.
+func TestWorkflow(t *testing.T) {
+	t.Parallel()
+
+	fake := starrtest.NewFakeServer(t, starr.Sonarr, "")
+	client := sonarr.New(starr.New(fake.APIKey, fake.URL, 0))
+
+	profile, err := client.AddQualityProfile(&sonarr.QualityProfile{Name: "HD"})
+	require.NoError(t, err)
+
+	tag, err := client.AddTag(&starr.Tag{Label: "kids"})
+	require.NoError(t, err)
+
+	series, err := client.AddSeries(&sonarr.AddSeriesInput{
+		Title:            "Some Show",
+		TvdbID:           1234,
+		QualityProfileID: profile.ID,
+		Tags:             []int{tag.ID},
+		Monitored:        true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), series.ID)
+	assert.Equal(t, []int{tag.ID}, series.Tags)
+
+	// Adding the same show again must fail validation.
+	_, err = client.AddSeries(&sonarr.AddSeriesInput{Title: "Some Show", TvdbID: 1234, QualityProfileID: profile.ID})
+	require.ErrorIs(t, err, &starr.ReqError{Code: http.StatusBadRequest})
+	assert.Equal(t, "TvdbId", starr.GetValidationErrors(err)[0].PropertyName)
+
+	found, err := client.GetSeries(1234)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, "Some Show", found[0].Title)
+
+	cmd, err := client.SendCommand(&sonarr.CommandRequest{Name: "SeriesSearch", SeriesID: series.ID})
+	require.NoError(t, err)
+	assert.Equal(t, "queued", cmd.Status)
+
+	for _, status := range []string{"started", "completed"} {
+		cmd, err = client.GetCommandStatus(cmd.ID)
+		require.NoError(t, err)
+		assert.Equal(t, status, cmd.Status)
+	}
+
+	queue, err := client.GetQueue(0, 0)
+	require.NoError(t, err)
+	require.Len(t, queue.Records, 1)
+	assert.Equal(t, series.ID, queue.Records[0].SeriesID)
+	assert.Equal(t, "Some Show", queue.Records[0].Title)
+
+	require.NoError(t, client.DeleteQueue(queue.Records[0].ID, nil))
+	assert.Empty(t, fake.Get(starrtest.FakeQueue))
+
+	require.NoError(t, client.DeleteSeries(int(series.ID), true, false))
+
+	_, err = client.GetSeriesByID(series.ID)
+	require.ErrorIs(t, err, &starr.ReqError{Code: http.StatusNotFound})
+}
+
+func TestWorkflowAPIKey(t *testing.T) {
+	t.Parallel()
+
+	fake := starrtest.NewFakeServer(t, starr.Sonarr, "")
+	client := sonarr.New(starr.New("wrong key", fake.URL, 0))
+
+	_, err := client.GetTags()
+	require.ErrorIs(t, err, &starr.ReqError{Code: http.StatusUnauthorized})
+	require.NoError(t, client.Ping(), "ping does not require an API key")
+}
@@ -55,7 +55,12 @@ type Config struct {
 	HTTPUser string       `json:"httpUser" toml:"http_user" xml:"http_user" yaml:"httpUser"`
 	Username string       `json:"username" toml:"username"  xml:"username"  yaml:"username"`
 	Password string       `json:"password" toml:"password"  xml:"password"  yaml:"password"`
-	cookie   bool         // this probably doesn't work right.
+	// Retry is an optional policy to retry failed requests. Nil disables retries.
+	Retry *RetryPolicy `json:"-" toml:"-" xml:"-" yaml:"-"`
+	// Limiter is an optional rate limiter and concurrency cap. Create it with NewLimiter.
+	// Every app client made from this config shares it. Nil disables limits.
+	Limiter *Limiter `json:"-" toml:"-" xml:"-" yaml:"-"`
+	cookie  bool     // this probably doesn't work right.
 }
 
 // New returns a *starr.Config pointer. This pointer is safe to modify
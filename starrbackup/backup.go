@@ -0,0 +1,302 @@
+// Package starrbackup creates, downloads and keeps backups of many Starr app instances.
+// A Manager sends the Backup command to each instance, waits for it to finish, downloads
+// the new archive and checks that it has config.xml and the database. Then it deletes old
+// archives from the local directory, as set by the retention policy.
+//
+// Archives are saved in a directory per instance, named after the instance. The archives
+// on the instances are not touched; the apps keep their own backups and retention.
+// Instances that use Postgres do not put their database in the archive, so they fail Verify.
+package starrbackup
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golift.io/starr"
+)
+
+// Errors returned by this package.
+var (
+	ErrNoName        = errors.New("starrbackup: instance has no name")
+	ErrCommandFailed = errors.New("starrbackup: backup command did not complete")
+	ErrNoBackup      = errors.New("starrbackup: backup command did not create a backup")
+	ErrMissingFile   = errors.New("starrbackup: archive is missing a file")
+)
+
+// backupType is the type of backups created with the Backup command.
+const backupType = "manual"
+
+// API is the part of a *starr.Config this package uses. Tests can replace it.
+type API interface {
+	GetInto(ctx context.Context, req starr.Request, output any) error
+	PostInto(ctx context.Context, req starr.Request, output any) error
+	DownloadBackup(ctx context.Context, backup *starr.BackupFile, output io.Writer) error
+}
+
+// Config must satisfy the API interface.
+var _ API = (*starr.Config)(nil)
+
+// Instance is one app to back up.
+type Instance struct {
+	// Name identifies the instance, and names its archive directory. It must be unique.
+	Name string
+	// App selects the API version. Sonarr and Radarr use v3, the others use v1.
+	App starr.App
+	// API is the instance's *starr.Config.
+	API API
+}
+
+// Manager backs up instances into a local directory. Create one with New, and adjust the
+// exported fields before calling Backup or BackupAll.
+type Manager struct {
+	// Dir is the directory to save archives in. Each instance gets its own directory in it.
+	Dir string
+	// Keep is how many archives to keep for each instance, newest first. 0 keeps them all.
+	Keep int
+	// MaxAge deletes archives older than this. 0 keeps archives of any age.
+	// The newest archive is always kept.
+	MaxAge time.Duration
+	// Parallel is how many instances BackupAll backs up at once. Less than 1 is 1.
+	Parallel int
+}
+
+// Result is the outcome of backing up one instance.
+type Result struct {
+	// Instance is the instance name.
+	Instance string
+	// Backup is the new backup on the instance.
+	Backup *starr.BackupFile
+	// File is the downloaded archive.
+	File string
+	// Removed are the old archives deleted by the retention policy.
+	Removed []string
+}
+
+// command is the part of a command resource this package uses. It's the same in every app.
+type command struct {
+	ID      int64               `json:"id"`
+	Name    string              `json:"name"`
+	Status  starr.CommandStatus `json:"status"`
+	Message string              `json:"message"`
+}
+
+// New returns a manager that saves archives in dir and keeps them all.
+func New(dir string) *Manager {
+	return &Manager{Dir: dir}
+}
+
+// BackupAll backs up every instance. Every instance is tried; the errors are joined.
+// Results are in the same order as the instances. The result for an instance that failed
+// is nil, or has the backup it created but no File.
+func (m *Manager) BackupAll(ctx context.Context, instances ...*Instance) ([]*Result, error) {
+	results := make([]*Result, len(instances))
+	errs := make([]error, len(instances))
+	limit := make(chan struct{}, max(m.Parallel, 1))
+
+	var wg sync.WaitGroup
+
+	for idx, instance := range instances {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			limit <- struct{}{}
+			defer func() { <-limit }()
+
+			results[idx], errs[idx] = m.Backup(ctx, instance)
+		}()
+	}
+
+	wg.Wait()
+
+	return results, errors.Join(errs...)
+}
+
+// Backup creates a backup on one instance, downloads and verifies it, then applies the retention policy.
+// An archive that fails verification is deleted.
+func (m *Manager) Backup(ctx context.Context, instance *Instance) (*Result, error) {
+	if instance.Name == "" {
+		return nil, ErrNoName
+	}
+
+	before, err := getBackups(ctx, instance)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := runBackup(ctx, instance); err != nil {
+		return nil, err
+	}
+
+	backup, err := newBackup(ctx, instance, before)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{Instance: instance.Name, Backup: backup}
+
+	if result.File, err = m.download(ctx, instance, backup); err != nil {
+		return result, err
+	}
+
+	result.Removed, err = m.Prune(instance.Name)
+
+	return result, err
+}
+
+// apiVer returns the API version for an app.
+func apiVer(app starr.App) string {
+	if app == starr.Sonarr || app == starr.Radarr {
+		return "v3"
+	}
+
+	return "v1"
+}
+
+func getBackups(ctx context.Context, instance *Instance) ([]*starr.BackupFile, error) {
+	var output []*starr.BackupFile
+
+	req := starr.Request{URI: path.Join(apiVer(instance.App), "system", "backup")}
+	if err := instance.API.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("%s: api.Get(%s): %w", instance.Name, &req, err)
+	}
+
+	return output, nil
+}
+
+// runBackup sends the Backup command and waits for it to finish.
+func runBackup(ctx context.Context, instance *Instance) error {
+	var output command
+
+	uri := path.Join(apiVer(instance.App), "command")
+	body := strings.NewReader(`{"name":"Backup"}`)
+
+	req := starr.Request{URI: uri, Body: body}
+	if err := instance.API.PostInto(ctx, req, &output); err != nil {
+		return fmt.Errorf("%s: api.Post(%s): %w", instance.Name, &req, err)
+	}
+
+	result, err := starr.WaitForCommand(ctx, func(ctx context.Context) (*command, starr.CommandStatus, error) {
+		var status command
+
+		req := starr.Request{URI: path.Join(uri, starr.Str(output.ID))}
+		if err := instance.API.GetInto(ctx, req, &status); err != nil {
+			return nil, "", fmt.Errorf("api.Get(%s): %w", &req, err)
+		}
+
+		return &status, status.Status, nil
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", instance.Name, err)
+	}
+
+	if result.Status != starr.CommandCompleted {
+		return fmt.Errorf("%w: %s: %s %s", ErrCommandFailed, instance.Name, result.Status, result.Command.Message)
+	}
+
+	return nil
+}
+
+// newBackup returns the newest manual backup that was not in the before list.
+func newBackup(ctx context.Context, instance *Instance, before []*starr.BackupFile) (*starr.BackupFile, error) {
+	after, err := getBackups(ctx, instance)
+	if err != nil {
+		return nil, err
+	}
+
+	var newest *starr.BackupFile
+
+	for _, backup := range after {
+		if backup.Type != backupType || slices.ContainsFunc(before, func(old *starr.BackupFile) bool {
+			return old.Name == backup.Name
+		}) {
+			continue
+		}
+
+		if newest == nil || backup.Time.After(newest.Time) {
+			newest = backup
+		}
+	}
+
+	if newest == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNoBackup, instance.Name)
+	}
+
+	return newest, nil
+}
+
+// download saves a backup in the instance's directory and verifies it.
+// The archive is written to a temporary file first, so a failed download never looks like a backup.
+func (m *Manager) download(ctx context.Context, instance *Instance, backup *starr.BackupFile) (string, error) {
+	dir := m.dir(instance.Name)
+	if err := os.MkdirAll(dir, 0o755); err != nil { //nolint:mnd
+		return "", fmt.Errorf("creating backup directory: %w", err)
+	}
+
+	file, err := os.CreateTemp(dir, ".download-*")
+	if err != nil {
+		return "", fmt.Errorf("creating backup file: %w", err)
+	}
+	defer os.Remove(file.Name()) // Does nothing after the rename.
+
+	err = instance.API.DownloadBackup(ctx, backup, file)
+	if closeErr := file.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("closing backup file: %w", closeErr)
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", instance.Name, err)
+	}
+
+	if err := Verify(file.Name()); err != nil {
+		return "", fmt.Errorf("%s: %s: %w", instance.Name, backup.Name, err)
+	}
+
+	name := filepath.Join(dir, filepath.Base(backup.Name))
+	if err := os.Rename(file.Name(), name); err != nil {
+		return "", fmt.Errorf("saving backup file: %w", err)
+	}
+
+	return name, nil
+}
+
+// Verify returns an error if a file is not a zip archive with config.xml and a database (*.db) in it.
+func Verify(name string) error {
+	archive, err := zip.OpenReader(name)
+	if err != nil {
+		return fmt.Errorf("opening archive: %w", err)
+	}
+	defer archive.Close()
+
+	var config, database bool
+
+	for _, file := range archive.File {
+		switch base := path.Base(file.Name); {
+		case strings.EqualFold(base, "config.xml"):
+			config = true
+		case strings.HasSuffix(strings.ToLower(base), ".db") && file.UncompressedSize64 > 0:
+			database = true
+		}
+	}
+
+	if !config {
+		return fmt.Errorf("%w: config.xml", ErrMissingFile)
+	}
+
+	if !database {
+		return fmt.Errorf("%w: database", ErrMissingFile)
+	}
+
+	return nil
+}
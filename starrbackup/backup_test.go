@@ -0,0 +1,170 @@
+package starrbackup_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/starrbackup"
+)
+
+// fakeApp serves the backup and command endpoints of one app. Each Backup command adds an archive.
+type fakeApp struct {
+	mu      sync.Mutex
+	files   map[string]string // archive content by name.
+	backups []*starr.BackupFile
+}
+
+func zipFile(t *testing.T, names ...string) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	archive := zip.NewWriter(&buf)
+
+	for _, name := range names {
+		file, err := archive.Create(name)
+		require.NoError(t, err)
+		_, err = file.Write([]byte("data"))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, archive.Close())
+
+	return buf.String()
+}
+
+func newFakeApp(t *testing.T, ver string, content string) *httptest.Server {
+	t.Helper()
+
+	app := &fakeApp{files: map[string]string{}}
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /api/"+ver+"/system/backup", func(w http.ResponseWriter, _ *http.Request) {
+		app.mu.Lock()
+		defer app.mu.Unlock()
+		assert.NoError(t, json.NewEncoder(w).Encode(app.backups))
+	})
+	mux.HandleFunc("POST /api/"+ver+"/command", func(w http.ResponseWriter, _ *http.Request) {
+		app.mu.Lock()
+		defer app.mu.Unlock()
+
+		name := "backup_" + starr.Str(int64(len(app.backups)+1)) + ".zip"
+		app.files[name] = content
+		app.backups = append(app.backups, &starr.BackupFile{
+			ID: int64(len(app.backups) + 1), Name: name, Type: "manual",
+			Path: "/backup/manual/" + name, Time: time.Now(),
+		})
+		_, _ = w.Write([]byte(`{"id": 7, "name": "Backup", "status": "queued"}`))
+	})
+	mux.HandleFunc("GET /api/"+ver+"/command/7", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"id": 7, "name": "Backup", "status": "completed"}`))
+	})
+	mux.HandleFunc("GET /backup/manual/{name}", func(w http.ResponseWriter, r *http.Request) {
+		app.mu.Lock()
+		defer app.mu.Unlock()
+		assert.Equal(t, "apikey", r.Header.Get("X-Api-Key"))
+		_, _ = w.Write([]byte(app.files[r.PathValue("name")]))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestBackupAll(t *testing.T) {
+	t.Parallel()
+
+	sonarr := newFakeApp(t, "v3", zipFile(t, "config.xml", "sonarr.db"))
+	prowlarr := newFakeApp(t, "v1", zipFile(t, "config.xml", "prowlarr.db"))
+	broken := newFakeApp(t, "v1", zipFile(t, "config.xml"))
+
+	manager := starrbackup.New(t.TempDir())
+	manager.Keep = 2
+	manager.Parallel = 2
+	instances := []*starrbackup.Instance{
+		{Name: "sonarr", App: starr.Sonarr, API: starr.New("apikey", sonarr.URL, 0)},
+		{Name: "prowlarr", App: starr.Prowlarr, API: starr.New("apikey", prowlarr.URL, 0)},
+		{Name: "lidarr", App: starr.Lidarr, API: starr.New("apikey", broken.URL, 0)},
+	}
+
+	for range 3 {
+		results, err := manager.BackupAll(t.Context(), instances...)
+		require.ErrorIs(t, err, starrbackup.ErrMissingFile, "the lidarr archive has no database")
+		require.Len(t, results, 3)
+		require.NotNil(t, results[0])
+		assert.FileExists(t, results[0].File)
+		assert.FileExists(t, results[1].File)
+		assert.Empty(t, results[2].File, "an archive that fails verification is not kept")
+		// Make sure the next archives are newer.
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	files, err := filepath.Glob(filepath.Join(manager.Dir, "sonarr", "*"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(manager.Dir, "sonarr", "backup_2.zip"),
+		filepath.Join(manager.Dir, "sonarr", "backup_3.zip"),
+	}, files, "only the newest two archives are kept")
+
+	files, err = filepath.Glob(filepath.Join(manager.Dir, "lidarr", "*"))
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func TestPrune(t *testing.T) {
+	t.Parallel()
+
+	manager := starrbackup.New(t.TempDir())
+	manager.MaxAge = time.Hour
+	dir := filepath.Join(manager.Dir, "radarr")
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+
+	for idx, age := range []time.Duration{3 * time.Hour, 2 * time.Hour, time.Minute} {
+		name := filepath.Join(dir, "backup_"+starr.Str(int64(idx))+".zip")
+		require.NoError(t, os.WriteFile(name, []byte("zip"), 0o600))
+		require.NoError(t, os.Chtimes(name, time.Now().Add(-age), time.Now().Add(-age)))
+	}
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("keep me"), 0o600))
+
+	removed, err := manager.Prune("radarr")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{filepath.Join(dir, "backup_0.zip"), filepath.Join(dir, "backup_1.zip")}, removed)
+	assert.FileExists(t, filepath.Join(dir, "backup_2.zip"))
+	assert.FileExists(t, filepath.Join(dir, "notes.txt"))
+
+	// The newest archive is kept, no matter how old.
+	manager.MaxAge = time.Second
+	removed, err = manager.Prune("radarr")
+	require.NoError(t, err)
+	assert.Empty(t, removed)
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	good := filepath.Join(dir, "good.zip")
+	require.NoError(t, os.WriteFile(good, []byte(zipFile(t, "config.xml", "readarr.db")), 0o600))
+	require.NoError(t, starrbackup.Verify(good))
+
+	noConfig := filepath.Join(dir, "noconfig.zip")
+	require.NoError(t, os.WriteFile(noConfig, []byte(zipFile(t, "readarr.db")), 0o600))
+	require.ErrorIs(t, starrbackup.Verify(noConfig), starrbackup.ErrMissingFile)
+
+	notZip := filepath.Join(dir, "html.zip")
+	require.NoError(t, os.WriteFile(notZip, []byte("<html>login</html>"), 0o600))
+	require.Error(t, starrbackup.Verify(notZip))
+}
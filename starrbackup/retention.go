@@ -0,0 +1,75 @@
+package starrbackup
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+// archive is a downloaded backup in an instance directory.
+type archive struct {
+	name    string
+	modTime time.Time
+}
+
+// Prune applies the retention policy to an instance's directory, and returns the deleted archives.
+// Backup calls this after each download. Only zip files are deleted; the newest one is always kept.
+func (m *Manager) Prune(instance string) ([]string, error) {
+	if instance == "" {
+		return nil, ErrNoName
+	}
+
+	archives, err := m.archives(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	removed := []string{}
+
+	for idx, archive := range archives {
+		tooMany := m.Keep > 0 && idx >= m.Keep
+		tooOld := m.MaxAge > 0 && time.Since(archive.modTime) > m.MaxAge
+
+		if idx == 0 || (!tooMany && !tooOld) {
+			continue
+		}
+
+		if err := os.Remove(archive.name); err != nil {
+			return removed, fmt.Errorf("deleting old backup: %w", err)
+		}
+
+		removed = append(removed, archive.name)
+	}
+
+	return removed, nil
+}
+
+// dir returns an instance's archive directory.
+func (m *Manager) dir(instance string) string {
+	return filepath.Join(m.Dir, filepath.Base(instance))
+}
+
+// archives returns the zip files in an instance's directory, newest first.
+func (m *Manager) archives(instance string) ([]*archive, error) {
+	names, err := filepath.Glob(filepath.Join(m.dir(instance), "*.zip"))
+	if err != nil {
+		return nil, fmt.Errorf("listing backups: %w", err)
+	}
+
+	archives := make([]*archive, 0, len(names))
+
+	for _, name := range names {
+		info, err := os.Stat(name)
+		if err != nil {
+			return nil, fmt.Errorf("listing backups: %w", err)
+		}
+
+		archives = append(archives, &archive{name: name, modTime: info.ModTime()})
+	}
+
+	slices.SortFunc(archives, func(a, b *archive) int { return b.modTime.Compare(a.modTime) })
+
+	return archives, nil
+}
@@ -0,0 +1,288 @@
+package starrconfig
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+
+	"golift.io/starr"
+)
+
+// Action is what Apply did (or would do) to a resource.
+type Action string
+
+// Actions found in a Change.
+const (
+	Created   Action = "created"
+	Updated   Action = "updated"
+	Deleted   Action = "deleted"
+	Unchanged Action = "unchanged"
+)
+
+// Options change how Apply works.
+type Options struct {
+	// Prune deletes resources that are not in the document. Only lists in the document are pruned.
+	// Tags and quality definitions are never deleted.
+	Prune bool
+	// DryRun reports every change without making it.
+	DryRun bool
+}
+
+// Change is one resource that Apply created, updated, deleted, or left alone.
+type Change struct {
+	Kind   string // Like KindQualityProfile.
+	Name   string // The resource's name, label or match key.
+	Action Action
+}
+
+// Report lists every resource Apply looked at, in the order they were applied.
+type Report struct {
+	Changes []*Change
+}
+
+// Count returns the number of changes with an action.
+func (r *Report) Count(action Action) int {
+	count := 0
+
+	for _, change := range r.Changes {
+		if change.Action == action {
+			count++
+		}
+	}
+
+	return count
+}
+
+// applier holds the state for one Apply call.
+type applier struct {
+	api    starr.APIer
+	ref    *refs
+	opts   *Options
+	report *Report
+	fakeID int64 // Used for resources "created" in a dry run.
+}
+
+// Apply makes a Sonarr or Radarr instance match a document. Resources are matched by name
+// (quality definitions by quality name, and delay profiles by tags). Missing resources are created,
+// different resources are updated, and identical resources are left alone; so applying the same
+// document twice changes nothing the second time. If an error occurs, the report so far is returned.
+func Apply(ctx context.Context, api starr.APIer, doc *Document, opts *Options) (*Report, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	if err := supported(doc.App); err != nil {
+		return nil, err
+	}
+
+	if doc.Version != Version {
+		return nil, fmt.Errorf("%w: %d", ErrVersion, doc.Version)
+	}
+
+	ref, err := loadRefs(ctx, api)
+	if err != nil {
+		return nil, err
+	}
+
+	apply := &applier{api: api, ref: ref, opts: opts, report: &Report{}}
+
+	for _, label := range doc.Tags {
+		if _, err := apply.tag(ctx, label); err != nil {
+			return apply.report, err
+		}
+	}
+
+	for _, kind := range kinds {
+		if list := *kind.list(doc); list != nil {
+			if err := apply.list(ctx, kind, list); err != nil {
+				return apply.report, err
+			}
+		}
+	}
+
+	for _, setting := range settings {
+		if obj := *setting.get(doc); obj != nil {
+			if err := apply.setting(ctx, setting.path, obj); err != nil {
+				return apply.report, err
+			}
+		}
+	}
+
+	return apply.report, nil
+}
+
+func (a *applier) add(kind, name string, action Action) {
+	a.report.Changes = append(a.report.Changes, &Change{Kind: kind, Name: name, Action: action})
+}
+
+// tag returns the ID for a tag label, and creates the tag if it does not exist.
+func (a *applier) tag(ctx context.Context, label string) (int64, error) {
+	if id, ok := a.ref.ids[KindTag][label]; ok {
+		a.add(KindTag, label, Unchanged)
+		return id, nil
+	}
+
+	id, err := a.create(ctx, KindTag, false, Object{"label": label})
+	if err != nil {
+		return 0, err
+	}
+
+	a.ref.add(KindTag, label, id)
+	a.add(KindTag, label, Created)
+
+	return id, nil
+}
+
+// create POSTs a new resource and returns its ID. A dry run returns a fake negative ID.
+func (a *applier) create(ctx context.Context, kind string, force bool, obj Object) (int64, error) {
+	if a.opts.DryRun {
+		a.fakeID--
+		return a.fakeID, nil
+	}
+
+	output, err := send(ctx, a.api, http.MethodPost, uri(kind), force, obj)
+	if err != nil {
+		return 0, err
+	}
+
+	return toInt64(output["id"]), nil
+}
+
+// list applies one kind of resource list.
+func (a *applier) list(ctx context.Context, kind *kind, desired []Object) error {
+	list, err := getList(ctx, a.api, kind.path)
+	if err != nil {
+		return err
+	}
+
+	current, err := kind.byKey(list, a.ref.toDoc)
+	if err != nil {
+		return fmt.Errorf("current resources: %w", err)
+	}
+
+	seen := make(map[string]bool, len(desired))
+
+	for _, want := range desired {
+		name := kind.key(want)
+
+		switch {
+		case name == "":
+			return fmt.Errorf("%w: %s", ErrNoName, kind.path)
+		case seen[name]:
+			return fmt.Errorf("document: %w: %s %q", ErrDuplicateName, kind.path, name)
+		}
+
+		seen[name] = true
+
+		if err := a.item(ctx, kind, name, want, current[name]); err != nil {
+			return fmt.Errorf("%s %q: %w", kind.path, name, err)
+		}
+	}
+
+	if !a.opts.Prune || kind.fixed {
+		return nil
+	}
+
+	for _, obj := range list {
+		if name := kind.key(a.ref.toDoc(obj)); !seen[name] {
+			if err := a.delete(ctx, kind.path, name, toInt64(obj["id"])); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// item creates or updates one resource. have is nil if the resource does not exist.
+func (a *applier) item(ctx context.Context, kind *kind, name string, want, have Object) error {
+	switch {
+	case have == nil && kind.fixed:
+		return fmt.Errorf("%w: this kind cannot be created", ErrMissingReference)
+	case have != nil && matches(want, a.ref.toDoc(have)):
+		a.add(kind.path, name, Unchanged)
+		return nil
+	}
+
+	body, err := a.ref.fromDoc(want, func(label string) (int64, error) { return a.tag(ctx, label) })
+	if err != nil {
+		return err
+	}
+
+	if have == nil {
+		id, err := a.create(ctx, kind.path, kind.force, body)
+		if err != nil {
+			return err
+		}
+
+		a.ref.add(kind.path, name, id)
+		a.add(kind.path, name, Created)
+
+		return nil
+	}
+
+	if err := a.update(ctx, uri(kind.path, starr.Str(toInt64(have["id"]))), kind.force, have, body); err != nil {
+		return err
+	}
+
+	a.add(kind.path, name, Updated)
+
+	return nil
+}
+
+// update PUTs the current resource with the desired values on top, so values the document does not have are kept.
+func (a *applier) update(ctx context.Context, uri string, force bool, have, body Object) error {
+	if a.opts.DryRun {
+		return nil
+	}
+
+	merged := maps.Clone(have)
+	maps.Copy(merged, body)
+
+	_, err := send(ctx, a.api, http.MethodPut, uri, force, merged)
+
+	return err
+}
+
+func (a *applier) delete(ctx context.Context, kind, name string, id int64) error {
+	if !a.opts.DryRun {
+		req := starr.Request{URI: uri(kind, starr.Str(id))}
+		if err := a.api.DeleteAny(ctx, req); err != nil {
+			return fmt.Errorf("api.Delete(%s): %w", &req, err)
+		}
+	}
+
+	a.ref.remove(kind, name)
+	a.add(kind, name, Deleted)
+
+	return nil
+}
+
+// setting applies a single-object setting, like naming.
+func (a *applier) setting(ctx context.Context, path string, want Object) error {
+	var have Object
+
+	req := starr.Request{URI: uri(path)}
+	if err := a.api.GetInto(ctx, req, &have); err != nil {
+		return fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	if matches(want, a.ref.toDoc(have)) {
+		a.add(path, path, Unchanged)
+		return nil
+	}
+
+	body, err := a.ref.fromDoc(want, func(label string) (int64, error) { return a.tag(ctx, label) })
+	if err != nil {
+		return err
+	}
+
+	if err := a.update(ctx, uri(path), false, have, body); err != nil {
+		return err
+	}
+
+	a.add(path, path, Updated)
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package starrconfig_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/starrconfig"
+	"golift.io/starr/starrtest"
+)
+
+// source returns a fake Sonarr with one of most things.
+func source(t *testing.T) *starrtest.FakeServer {
+	t.Helper()
+
+	fake := starrtest.NewFakeServer(t, starr.Sonarr, "")
+
+	seed := func(resource string, objects ...any) {
+		t.Helper()
+
+		_, err := fake.Seed(resource, objects...)
+		require.NoError(t, err)
+	}
+
+	seed(starrtest.FakeTag, starr.Tag{Label: "unused"}, starr.Tag{Label: "anime"}, starr.Tag{Label: "kids"})
+	seed(starrtest.FakeCustomFormat,
+		starrtest.FakeObject{"name": "Junk"},
+		starrtest.FakeObject{"name": "x265", "includeCustomFormatWhenRenaming": false})
+	seed(starrtest.FakeQualityDefinition,
+		starrtest.FakeObject{"quality": map[string]any{"id": 1, "name": "SDTV"}, "title": "SDTV", "maxSize": 100})
+	seed(starrtest.FakeQualityProfile, starrtest.FakeObject{
+		"name":        "HD",
+		"formatItems": []any{map[string]any{"format": 2, "name": "x265", "score": 100}},
+	})
+	seed(starrtest.FakeDelayProfile, starrtest.FakeObject{"tags": []any{2, 3}, "usenetDelay": 60})
+	seed(starrtest.FakeDownloadClient, starrtest.FakeObject{
+		"name": "qBit", "implementation": "QBittorrent", "implementationName": "qBittorrent",
+		"fields": []any{
+			map[string]any{"name": "host", "value": "localhost", "label": "Host", "helpText": "..."},
+			map[string]any{"name": "password", "value": "********", "label": "Password"},
+		},
+	})
+	seed(starrtest.FakeIndexer, starrtest.FakeObject{"name": "Nyaa", "downloadClientId": 1, "tags": []any{2}})
+	seed(starrtest.FakeNaming, starrtest.FakeObject{"renameEpisodes": true, "seasonFolderFormat": "Season {season}"})
+
+	return fake
+}
+
+func TestExportApply(t *testing.T) {
+	t.Parallel()
+
+	from := source(t)
+	doc, err := starrconfig.Export(t.Context(), starr.New(from.APIKey, from.URL, 0), starr.Sonarr)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"anime", "kids", "unused"}, doc.Tags)
+	assert.Equal(t, "Nyaa", doc.Indexers[0]["name"])
+	assert.Equal(t, "qBit", doc.Indexers[0]["downloadClient"], "the client ID must be exported as its name")
+	assert.Equal(t, []any{"anime"}, doc.Indexers[0]["tags"], "tag IDs must be exported as labels")
+	assert.NotContains(t, doc.Indexers[0], "id")
+	assert.Equal(t, []any{map[string]any{"name": "host", "value": "localhost"},
+		map[string]any{"name": "password", "value": "********"}}, doc.DownloadClients[0]["fields"])
+	assert.NotContains(t, doc.QualityProfiles[0]["formatItems"].([]any)[0], "format")
+	assert.Empty(t, doc.ReleaseProfiles)
+
+	// Round trip the document, like a user storing it in a file.
+	var buf bytes.Buffer
+	require.NoError(t, doc.Write(&buf))
+	doc, err = starrconfig.Read(&buf)
+	require.NoError(t, err)
+
+	// The target has different IDs for everything, and a quality definition to update.
+	dest := starrtest.NewFakeServer(t, starr.Sonarr, "")
+	_, err = dest.Seed(starrtest.FakeTag, starr.Tag{Label: "kids"}, starr.Tag{Label: "old"})
+	require.NoError(t, err)
+	_, err = dest.Seed(starrtest.FakeCustomFormat, starrtest.FakeObject{"name": "Stale"})
+	require.NoError(t, err)
+	_, err = dest.Seed(starrtest.FakeQualityDefinition,
+		starrtest.FakeObject{"quality": map[string]any{"id": 1, "name": "SDTV"}, "title": "SDTV", "maxSize": 50})
+	require.NoError(t, err)
+
+	config := starr.New(dest.APIKey, dest.URL, 0)
+
+	// A dry run reports the changes, but makes none.
+	report, err := starrconfig.Apply(t.Context(), config, doc, &starrconfig.Options{DryRun: true, Prune: true})
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Count(starrconfig.Deleted))
+	assert.Len(t, dest.Get(starrtest.FakeTag), 2)
+	assert.Empty(t, dest.Get(starrtest.FakeIndexer))
+
+	report, err = starrconfig.Apply(t.Context(), config, doc, &starrconfig.Options{Prune: true})
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Count(starrconfig.Deleted), "the Stale custom format must be pruned")
+	assert.Equal(t, 2, report.Count(starrconfig.Updated), "the quality definition and naming must be updated")
+	assert.Equal(t, 8, report.Count(starrconfig.Created),
+		"2 tags, 2 formats, a profile, a delay profile, a client and an indexer must be created")
+
+	tags := map[string]int64{}
+	for _, tag := range dest.Get(starrtest.FakeTag) {
+		tags[tag["label"].(string)] = tag["id"].(int64)
+	}
+
+	assert.Equal(t, map[string]int64{"kids": 1, "old": 2, "anime": 3, "unused": 4}, tags)
+
+	formats := dest.Get(starrtest.FakeCustomFormat)
+	require.Len(t, formats, 2)
+	assert.Equal(t, "x265", formats[1]["name"])
+
+	profile := dest.Get(starrtest.FakeQualityProfile)[0]
+	assert.EqualValues(t, formats[1]["id"], profile["formatItems"].([]any)[0].(map[string]any)["format"],
+		"the custom format must be remapped to the new ID")
+
+	indexer := dest.Get(starrtest.FakeIndexer)[0]
+	assert.EqualValues(t, dest.Get(starrtest.FakeDownloadClient)[0]["id"], indexer["downloadClientId"])
+	assert.Equal(t, []any{float64(3)}, indexer["tags"])
+	assert.ElementsMatch(t, []any{float64(1), float64(3)}, dest.Get(starrtest.FakeDelayProfile)[0]["tags"])
+	assert.EqualValues(t, 100, dest.Get(starrtest.FakeQualityDefinition)[0]["maxSize"])
+	assert.Equal(t, true, dest.Get(starrtest.FakeNaming)[0]["renameEpisodes"])
+
+	// Applying the same document again changes nothing.
+	report, err = starrconfig.Apply(t.Context(), config, doc, &starrconfig.Options{Prune: true})
+	require.NoError(t, err)
+	assert.Equal(t, len(report.Changes), report.Count(starrconfig.Unchanged), "apply must be idempotent")
+}
+
+func TestApplyErrors(t *testing.T) {
+	t.Parallel()
+
+	dest := starrtest.NewFakeServer(t, starr.Sonarr, "")
+	config := starr.New(dest.APIKey, dest.URL, 0)
+
+	doc := &starrconfig.Document{Version: starrconfig.Version, App: starr.Lidarr}
+	_, err := starrconfig.Apply(t.Context(), config, doc, nil)
+	require.ErrorIs(t, err, starrconfig.ErrUnsupportedApp)
+
+	doc.App = starr.Sonarr
+	doc.Indexers = []starrconfig.Object{{"name": "Nyaa", "downloadClient": "missing"}}
+	_, err = starrconfig.Apply(t.Context(), config, doc, nil)
+	require.ErrorIs(t, err, starrconfig.ErrMissingReference)
+
+	doc.Indexers = []starrconfig.Object{{"implementation": "Newznab"}}
+	_, err = starrconfig.Apply(t.Context(), config, doc, nil)
+	require.ErrorIs(t, err, starrconfig.ErrNoName)
+
+	doc.Indexers = []starrconfig.Object{{"name": "Nyaa"}, {"name": "Nyaa"}}
+	_, err = starrconfig.Apply(t.Context(), config, doc, &starrconfig.Options{DryRun: true})
+	require.ErrorIs(t, err, starrconfig.ErrDuplicateName, "two resources in the document have the same name")
+
+	// Two resources with the same name on the instance cannot be told apart, so nothing is pruned.
+	_, err = dest.Seed(starrtest.FakeCustomFormat,
+		starrtest.FakeObject{"name": "x265"}, starrtest.FakeObject{"name": "x265"})
+	require.NoError(t, err)
+
+	doc.Indexers = nil
+	doc.CustomFormats = []starrconfig.Object{}
+	_, err = starrconfig.Apply(t.Context(), config, doc, &starrconfig.Options{Prune: true})
+	require.ErrorIs(t, err, starrconfig.ErrDuplicateName)
+	assert.Len(t, dest.Get(starrtest.FakeCustomFormat), 2)
+
+	_, err = starrconfig.Read(bytes.NewBufferString(`{"version":99,"app":"Sonarr"}`))
+	require.ErrorIs(t, err, starrconfig.ErrVersion)
+}
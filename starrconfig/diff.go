@@ -0,0 +1,285 @@
+package starrconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	"golift.io/starr"
+)
+
+// Difference is how a resource or field differs between two instances.
+type Difference string
+
+// Differences found in a Diff. Added things are only on the second (drifted) instance,
+// and removed things are only on the first (golden) instance.
+const (
+	Added   Difference = "added"
+	Removed Difference = "removed"
+	Changed Difference = "changed"
+)
+
+// Diff lists the differences between two instances, or two documents.
+// Resources that are the same on both are not included.
+type Diff struct {
+	Resources []*ResourceDiff `json:"resources"`
+}
+
+// ResourceDiff is one resource that differs. Fields is only set for changed resources.
+type ResourceDiff struct {
+	Kind       string       `json:"kind"` // Like KindQualityProfile.
+	Name       string       `json:"name"` // The resource's name, label or match key.
+	Difference Difference   `json:"difference"`
+	Fields     []*FieldDiff `json:"fields,omitempty"`
+}
+
+// FieldDiff is one value that differs in a changed resource.
+// The path is dotted, and list items with a name are found by name, like formatItems[x265].score.
+type FieldDiff struct {
+	Path       string     `json:"path"`
+	Difference Difference `json:"difference"`
+	From       any        `json:"from,omitempty"`
+	To         any        `json:"to,omitempty"`
+}
+
+// Compare exports the configuration from two Sonarr or Radarr instances, and returns the differences.
+// The first instance is the reference ("golden") copy. Resources are matched by name, and
+// server-assigned values like IDs are ignored. Masked secrets are never reported as different.
+func Compare(ctx context.Context, golden, drifted starr.APIer, app starr.App) (*Diff, error) {
+	from, err := Export(ctx, golden, app)
+	if err != nil {
+		return nil, fmt.Errorf("golden instance: %w", err)
+	}
+
+	to, err := Export(ctx, drifted, app)
+	if err != nil {
+		return nil, fmt.Errorf("drifted instance: %w", err)
+	}
+
+	return CompareDocuments(from, to)
+}
+
+// CompareDocuments returns the differences between two documents. Kinds of resources that
+// are nil (not managed) in either document are not compared. Returns ErrDuplicateName if
+// a list has two resources with the same name, because they cannot be matched.
+func CompareDocuments(golden, drifted *Document) (*Diff, error) {
+	diff := &Diff{Resources: []*ResourceDiff{}}
+
+	if golden.Tags != nil && drifted.Tags != nil {
+		diff.tags(golden.Tags, drifted.Tags)
+	}
+
+	for _, kind := range kinds {
+		from, to := *kind.list(golden), *kind.list(drifted)
+		if from != nil && to != nil {
+			if err := diff.list(kind, from, to); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, setting := range settings {
+		from, to := *setting.get(golden), *setting.get(drifted)
+		if from != nil && to != nil {
+			diff.resource(setting.path, setting.path, from, to)
+		}
+	}
+
+	return diff, nil
+}
+
+func (d *Diff) tags(from, to []string) {
+	for _, label := range from {
+		if !slices.Contains(to, label) {
+			d.Resources = append(d.Resources, &ResourceDiff{Kind: KindTag, Name: label, Difference: Removed})
+		}
+	}
+
+	for _, label := range to {
+		if !slices.Contains(from, label) {
+			d.Resources = append(d.Resources, &ResourceDiff{Kind: KindTag, Name: label, Difference: Added})
+		}
+	}
+}
+
+func (d *Diff) list(kind *kind, from, to []Object) error {
+	fromObjs, err := kind.byKey(from, nil)
+	if err != nil {
+		return fmt.Errorf("golden: %w", err)
+	}
+
+	toObjs, err := kind.byKey(to, nil)
+	if err != nil {
+		return fmt.Errorf("drifted: %w", err)
+	}
+
+	names := slices.Sorted(maps.Keys(fromObjs))
+	for name := range maps.Keys(toObjs) {
+		if _, ok := fromObjs[name]; !ok {
+			names = append(names, name)
+		}
+	}
+
+	slices.Sort(names)
+
+	for _, name := range names {
+		switch fromObj, toObj := fromObjs[name], toObjs[name]; {
+		case toObj == nil:
+			d.Resources = append(d.Resources, &ResourceDiff{Kind: kind.path, Name: name, Difference: Removed})
+		case fromObj == nil:
+			d.Resources = append(d.Resources, &ResourceDiff{Kind: kind.path, Name: name, Difference: Added})
+		default:
+			d.resource(kind.path, name, fromObj, toObj)
+		}
+	}
+
+	return nil
+}
+
+// resource adds a changed resource if any of its fields are different.
+func (d *Diff) resource(kind, name string, from, to Object) {
+	fields := diffValues("", from, to, nil)
+	if len(fields) > 0 {
+		d.Resources = append(d.Resources, &ResourceDiff{Kind: kind, Name: name, Difference: Changed, Fields: fields})
+	}
+}
+
+// diffValues appends the differences between two decoded JSON values.
+func diffValues(path string, from, to any, fields []*FieldDiff) []*FieldDiff {
+	switch fromVal := from.(type) {
+	case map[string]any:
+		if toVal, ok := to.(map[string]any); ok {
+			return diffMaps(path, fromVal, toVal, fields)
+		}
+	case []any:
+		if toVal, ok := to.([]any); ok && named(fromVal) && named(toVal) {
+			return diffMaps(path, byName(fromVal), byName(toVal), fields)
+		}
+	}
+
+	if matches(from, to) && matches(to, from) {
+		return fields
+	}
+
+	return append(fields, &FieldDiff{Path: path, Difference: Changed, From: from, To: to})
+}
+
+func diffMaps(path string, from, to map[string]any, fields []*FieldDiff) []*FieldDiff {
+	keys := slices.Sorted(maps.Keys(from))
+	for key := range maps.Keys(to) {
+		if _, ok := from[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		fromVal, inFrom := from[key]
+		toVal, inTo := to[key]
+
+		switch keyPath := joinPath(path, key); {
+		case !inTo:
+			fields = append(fields, &FieldDiff{Path: keyPath, Difference: Removed, From: fromVal})
+		case !inFrom:
+			fields = append(fields, &FieldDiff{Path: keyPath, Difference: Added, To: toVal})
+		default:
+			fields = diffValues(keyPath, fromVal, toVal, fields)
+		}
+	}
+
+	return fields
+}
+
+// joinPath adds a key to a field path. Keys from byName are already wrapped in brackets.
+func joinPath(path, key string) string {
+	if path == "" || strings.HasPrefix(key, "[") {
+		return path + key
+	}
+
+	return path + "." + key
+}
+
+// named returns true if every item in a non-empty list is an object with a name,
+// like provider fields and quality profile format items.
+func named(list []any) bool {
+	for _, item := range list {
+		if obj, ok := item.(map[string]any); !ok || nameKey(obj) == "" {
+			return false
+		}
+	}
+
+	return len(list) > 0
+}
+
+func byName(list []any) map[string]any {
+	output := make(map[string]any, len(list))
+
+	for _, item := range list {
+		obj, _ := item.(map[string]any)
+		output["["+nameKey(obj)+"]"] = obj
+	}
+
+	return output
+}
+
+// Count returns the number of resources with a difference.
+func (d *Diff) Count(difference Difference) int {
+	count := 0
+
+	for _, resource := range d.Resources {
+		if resource.Difference == difference {
+			count++
+		}
+	}
+
+	return count
+}
+
+// String returns the differences as text, one resource per line, followed by its changed fields.
+func (d *Diff) String() string {
+	var buf strings.Builder
+
+	for _, resource := range d.Resources {
+		fmt.Fprintf(&buf, "%s %q: %s\n", resource.Kind, resource.Name, resource.Difference)
+
+		for _, field := range resource.Fields {
+			switch field.Difference {
+			case Added:
+				fmt.Fprintf(&buf, "  + %s: %s\n", field.Path, value(field.To))
+			case Removed:
+				fmt.Fprintf(&buf, "  - %s: %s\n", field.Path, value(field.From))
+			default:
+				fmt.Fprintf(&buf, "  ~ %s: %s -> %s\n", field.Path, value(field.From), value(field.To))
+			}
+		}
+	}
+
+	return buf.String()
+}
+
+// value formats a field value for text output.
+func value(val any) string {
+	output, err := json.Marshal(val)
+	if err != nil {
+		return fmt.Sprint(val)
+	}
+
+	return string(output)
+}
+
+// WriteJSON encodes the differences as indented JSON.
+func (d *Diff) WriteJSON(output io.Writer) error {
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(d); err != nil {
+		return fmt.Errorf("encoding diff: %w", err)
+	}
+
+	return nil
+}
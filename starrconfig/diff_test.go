@@ -0,0 +1,107 @@
+package starrconfig_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/starrconfig"
+	"golift.io/starr/starrtest"
+)
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	golden := starrtest.NewFakeServer(t, starr.Radarr, "")
+	drifted := starrtest.NewFakeServer(t, starr.Radarr, "")
+
+	seed := func(fake *starrtest.FakeServer, resource string, objects ...any) {
+		t.Helper()
+
+		_, err := fake.Seed(resource, objects...)
+		require.NoError(t, err)
+	}
+
+	// The IDs are different on each instance, and must not matter.
+	seed(golden, starrtest.FakeCustomFormat, starrtest.FakeObject{"name": "x265"})
+	seed(drifted, starrtest.FakeCustomFormat, starrtest.FakeObject{"name": "Junk"}, starrtest.FakeObject{"name": "x265"})
+	seed(golden, starrtest.FakeQualityProfile, starrtest.FakeObject{
+		"name": "HD", "cutoff": 7, "formatItems": []any{map[string]any{"format": 1, "name": "x265", "score": 100}},
+	})
+	seed(drifted, starrtest.FakeQualityProfile, starrtest.FakeObject{
+		"name": "HD", "cutoff": 7, "formatItems": []any{map[string]any{"format": 2, "name": "x265", "score": -10}},
+	})
+	seed(golden, starrtest.FakeIndexer, starrtest.FakeObject{"name": "Nyaa", "enableRss": true, "fields": []any{
+		map[string]any{"name": "baseUrl", "value": "https://nyaa.si"},
+		map[string]any{"name": "apiKey", "value": "********"},
+	}})
+	seed(drifted, starrtest.FakeIndexer, starrtest.FakeObject{"name": "Nyaa", "enableRss": false, "fields": []any{
+		map[string]any{"name": "baseUrl", "value": "https://nyaa.si"},
+		map[string]any{"name": "apiKey", "value": "********"},
+		map[string]any{"name": "minimumSeeders", "value": 1},
+	}})
+	seed(golden, starrtest.FakeDownloadClient, starrtest.FakeObject{"name": "qBit"})
+	seed(golden, starrtest.FakeNaming, starrtest.FakeObject{"renameMovies": true})
+	seed(drifted, starrtest.FakeNaming, starrtest.FakeObject{"renameMovies": true})
+
+	diff, err := starrconfig.Compare(t.Context(), starr.New(golden.APIKey, golden.URL, 0),
+		starr.New(drifted.APIKey, drifted.URL, 0), starr.Radarr)
+	require.NoError(t, err)
+
+	assert.Equal(t, []*starrconfig.ResourceDiff{
+		{Kind: starrconfig.KindCustomFormat, Name: "Junk", Difference: starrconfig.Added},
+		{
+			Kind: starrconfig.KindQualityProfile, Name: "HD", Difference: starrconfig.Changed,
+			Fields: []*starrconfig.FieldDiff{{
+				Path: "formatItems[x265].score", Difference: starrconfig.Changed, From: float64(100), To: float64(-10),
+			}},
+		},
+		{Kind: starrconfig.KindDownloadClient, Name: "qBit", Difference: starrconfig.Removed},
+		{
+			Kind: starrconfig.KindIndexer, Name: "Nyaa", Difference: starrconfig.Changed,
+			Fields: []*starrconfig.FieldDiff{
+				{Path: "enableRss", Difference: starrconfig.Changed, From: true, To: false},
+				{Path: "fields[minimumSeeders]", Difference: starrconfig.Added, To: map[string]any{
+					"name": "minimumSeeders", "value": float64(1),
+				}},
+			},
+		},
+	}, diff.Resources)
+	assert.Equal(t, 2, diff.Count(starrconfig.Changed))
+
+	assert.Equal(t, `customformat "Junk": added
+qualityprofile "HD": changed
+  ~ formatItems[x265].score: 100 -> -10
+downloadclient "qBit": removed
+indexer "Nyaa": changed
+  ~ enableRss: true -> false
+  + fields[minimumSeeders]: {"name":"minimumSeeders","value":1}
+`, diff.String())
+
+	var buf bytes.Buffer
+	require.NoError(t, diff.WriteJSON(&buf))
+
+	var decoded starrconfig.Diff
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, diff, &decoded)
+}
+
+func TestCompareDuplicates(t *testing.T) {
+	t.Parallel()
+
+	golden := &starrconfig.Document{CustomFormats: []starrconfig.Object{{"name": "x265"}}}
+	drifted := &starrconfig.Document{CustomFormats: []starrconfig.Object{{"name": "x265"}, {"name": "x265"}}}
+
+	_, err := starrconfig.CompareDocuments(golden, drifted)
+	require.ErrorIs(t, err, starrconfig.ErrDuplicateName, "a duplicate must not be hidden")
+
+	_, err = starrconfig.CompareDocuments(drifted, golden)
+	require.ErrorIs(t, err, starrconfig.ErrDuplicateName)
+
+	diff, err := starrconfig.CompareDocuments(golden, golden)
+	require.NoError(t, err)
+	assert.Empty(t, diff.Resources)
+}
@@ -0,0 +1,152 @@
+// Package starrconfig exports the settings of a Sonarr or Radarr instance into one versioned document,
+// and applies that document to the same or another instance. Use it to keep many instances in sync,
+// or to keep an instance's configuration in version control. Compare shows what differs between
+// two instances before you apply anything.
+//
+// The document does not contain instance IDs. References between resources are stored by name:
+// tags by label, custom formats in quality profiles by name, and download clients and indexers by name.
+// Apply maps those names to the IDs on the target instance, and creates missing tags.
+//
+// Documents are JSON or YAML: use Read and Write for JSON, and ReadYAML and WriteYAML for YAML.
+// YAML is handled by gopkg.in/yaml.v3.
+// Secrets (passwords, API keys) are masked by the apps when exported, and a masked value is
+// never sent as a change. Fill them in before applying a document to a new instance.
+package starrconfig
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"golift.io/starr"
+	"gopkg.in/yaml.v3"
+)
+
+// Version is the document format version written by Export and accepted by Read.
+const Version = 1
+
+// Errors returned by this package.
+var (
+	ErrUnsupportedApp   = errors.New("starrconfig: app is not supported")
+	ErrVersion          = errors.New("starrconfig: unsupported document version")
+	ErrMissingReference = errors.New("starrconfig: referenced resource does not exist")
+	ErrNoName           = errors.New("starrconfig: resource has no name")
+	ErrDuplicateName    = errors.New("starrconfig: more than one resource has the same name")
+)
+
+// Object is a single resource in a document. It's the app's JSON resource without its ID,
+// and with references converted to names. Edit it like any other decoded JSON object.
+type Object = map[string]any
+
+// Document contains the configuration of one instance. A nil list (or setting) is not managed:
+// Apply leaves that kind of resource alone. Remove a resource from a non-nil list to delete it
+// when Apply is called with Prune.
+type Document struct {
+	Version            int       `json:"version"`
+	App                starr.App `json:"app"`
+	Exported           time.Time `json:"exported,omitzero"`
+	Tags               []string  `json:"tags,omitempty"`
+	CustomFormats      []Object  `json:"customFormats,omitempty"`
+	QualityDefinitions []Object  `json:"qualityDefinitions,omitempty"`
+	QualityProfiles    []Object  `json:"qualityProfiles,omitempty"`
+	DelayProfiles      []Object  `json:"delayProfiles,omitempty"`
+	ReleaseProfiles    []Object  `json:"releaseProfiles,omitempty"`
+	DownloadClients    []Object  `json:"downloadClients,omitempty"`
+	Indexers           []Object  `json:"indexers,omitempty"`
+	Notifications      []Object  `json:"notifications,omitempty"`
+	Naming             Object    `json:"naming,omitempty"`
+	MediaManagement    Object    `json:"mediaManagement,omitempty"`
+}
+
+// Read decodes a JSON document and checks its version.
+func Read(input io.Reader) (*Document, error) {
+	var doc Document
+	if err := json.NewDecoder(input).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("decoding document: %w", err)
+	}
+
+	if doc.Version != Version {
+		return nil, fmt.Errorf("%w: %d", ErrVersion, doc.Version)
+	}
+
+	return &doc, nil
+}
+
+// Write encodes the document as indented JSON.
+func (d *Document) Write(output io.Writer) error {
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(d); err != nil {
+		return fmt.Errorf("encoding document: %w", err)
+	}
+
+	return nil
+}
+
+// ReadYAML decodes a YAML document and checks its version.
+// The document is converted to JSON first, so it decodes exactly like one from Read.
+func ReadYAML(input io.Reader) (*Document, error) {
+	var value any
+	if err := yaml.NewDecoder(input).Decode(&value); err != nil {
+		return nil, fmt.Errorf("decoding document: %w", err)
+	}
+
+	body, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("decoding document: %w", err)
+	}
+
+	return Read(bytes.NewReader(body))
+}
+
+// WriteYAML encodes the document as YAML. Keys are written in the same order as Write.
+func (d *Document) WriteYAML(output io.Writer) error {
+	body, err := json.Marshal(d)
+	if err != nil {
+		return fmt.Errorf("encoding document: %w", err)
+	}
+
+	// JSON is YAML, and a node keeps the key order.
+	var node yaml.Node
+	if err := yaml.Unmarshal(body, &node); err != nil {
+		return fmt.Errorf("encoding document: %w", err)
+	}
+
+	blockStyle(&node)
+
+	encoder := yaml.NewEncoder(output)
+	encoder.SetIndent(2) //nolint:mnd // two spaces, like most YAML.
+
+	if err := encoder.Encode(&node); err != nil {
+		return fmt.Errorf("encoding document: %w", err)
+	}
+
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("encoding document: %w", err)
+	}
+
+	return nil
+}
+
+// blockStyle clears the JSON flow style and quoting from a node, so the encoder picks them.
+func blockStyle(node *yaml.Node) {
+	node.Style = 0
+
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
+
+// supported returns an error if the app is not supported by this package.
+func supported(app starr.App) error {
+	switch app {
+	case starr.Sonarr, starr.Radarr:
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedApp, app)
+	}
+}
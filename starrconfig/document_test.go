@@ -0,0 +1,99 @@
+package starrconfig_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/starrconfig"
+)
+
+func TestYAMLRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	doc := &starrconfig.Document{
+		Version:  starrconfig.Version,
+		App:      starr.Sonarr,
+		Exported: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+		Tags:     []string{"anime", "4k", "true", "no", "with: colon", "# hash", "", "line\nbreak"},
+		CustomFormats: []starrconfig.Object{{
+			"name":                            "x265 (HD)",
+			"includeCustomFormatWhenRenaming": false,
+			"specifications": []any{map[string]any{
+				"name":   "x265",
+				"fields": []any{map[string]any{"name": "value", "value": `[xh]\.?265|HEVC`}},
+			}},
+		}},
+		QualityProfiles: []starrconfig.Object{{
+			"name":    "HD",
+			"cutoff":  float64(7),
+			"minimum": -10.5,
+			"items":   []any{[]any{"nested", float64(1)}, []any{}, map[string]any{}},
+			"empty":   nil,
+		}},
+	}
+
+	var jsonBuf, yamlBuf bytes.Buffer
+
+	require.NoError(t, doc.Write(&jsonBuf))
+	require.NoError(t, doc.WriteYAML(&yamlBuf))
+
+	fromJSON, err := starrconfig.Read(&jsonBuf)
+	require.NoError(t, err)
+
+	yaml := yamlBuf.String()
+	assert.True(t, strings.HasPrefix(yaml, "version: 1\napp: Sonarr\nexported: \"2025-03-01T12:00:00Z\"\n"),
+		"keys must be written in the same order as the JSON document")
+
+	fromYAML, err := starrconfig.ReadYAML(&yamlBuf)
+	require.NoError(t, err, yaml)
+	assert.Equal(t, fromJSON, fromYAML)
+}
+
+func TestReadYAML(t *testing.T) {
+	t.Parallel()
+
+	doc, err := starrconfig.ReadYAML(strings.NewReader(`---
+# A hand written document.
+version: 1
+app: Radarr
+tags: [hd, "4k"]
+indexers:
+  - &nyaa
+    name: Nyaa
+    enableRss: true
+    fields: [{name: baseUrl, value: "https://nyaa.si"}]
+  - <<: *nyaa
+    name: Nyaa Two
+naming:
+  standardMovieFormat: >-
+    {Movie Title}
+    ({Release Year})
+`))
+	require.NoError(t, err)
+
+	nyaa := starrconfig.Object{
+		"name":      "Nyaa",
+		"enableRss": true,
+		"fields":    []any{map[string]any{"name": "baseUrl", "value": "https://nyaa.si"}},
+	}
+	two := starrconfig.Object{"name": "Nyaa Two", "enableRss": true, "fields": nyaa["fields"]}
+
+	assert.Equal(t, &starrconfig.Document{
+		Version:  1,
+		App:      starr.Radarr,
+		Tags:     []string{"hd", "4k"},
+		Indexers: []starrconfig.Object{nyaa, two},
+		Naming:   starrconfig.Object{"standardMovieFormat": "{Movie Title} ({Release Year})"},
+	}, doc)
+
+	_, err = starrconfig.ReadYAML(strings.NewReader("version: 99\napp: Sonarr\n"))
+	require.ErrorIs(t, err, starrconfig.ErrVersion)
+
+	_, err = starrconfig.ReadYAML(strings.NewReader("version: 1\n\tapp: Sonarr\n"))
+	require.Error(t, err)
+}
@@ -0,0 +1,98 @@
+package starrconfig
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"golift.io/starr"
+)
+
+// Export reads the configuration from a Sonarr or Radarr instance into a document.
+// Pass in the app's client, like a *sonarr.Sonarr, or a *starr.Config.
+func Export(ctx context.Context, api starr.APIer, app starr.App) (*Document, error) {
+	if err := supported(app); err != nil {
+		return nil, err
+	}
+
+	ref, err := loadRefs(ctx, api)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &Document{Version: Version, App: app, Exported: time.Now().UTC(), Tags: []string{}}
+
+	for _, label := range ref.names[KindTag] {
+		doc.Tags = append(doc.Tags, label)
+	}
+
+	slices.Sort(doc.Tags)
+
+	for _, kind := range kinds {
+		list, err := getList(ctx, api, kind.path)
+		if err != nil {
+			return nil, err
+		}
+
+		output := make([]Object, 0, len(list))
+		for _, obj := range list {
+			output = append(output, ref.toDoc(obj))
+		}
+
+		*kind.list(doc) = output
+	}
+
+	for _, setting := range settings {
+		var obj Object
+
+		req := starr.Request{URI: uri(setting.path)}
+		if err := api.GetInto(ctx, req, &obj); err != nil {
+			return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+		}
+
+		*setting.get(doc) = ref.toDoc(obj)
+	}
+
+	return doc, nil
+}
+
+func getList(ctx context.Context, api starr.APIer, kind string) ([]Object, error) {
+	var output []Object
+
+	req := starr.Request{URI: uri(kind)}
+	if err := api.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// send POSTs or PUTs an object, and returns the app's response.
+func send(ctx context.Context, api starr.APIer, method, uri string, force bool, obj Object) (Object, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(obj); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", uri, err)
+	}
+
+	req := starr.Request{URI: uri, Body: &body}
+	if force {
+		req.Query = url.Values{"forceSave": []string{"true"}}
+	}
+
+	var output Object
+
+	if method == http.MethodPost {
+		if err := api.PostInto(ctx, req, &output); err != nil {
+			return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+		}
+	} else if err := api.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
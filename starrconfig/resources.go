@@ -0,0 +1,404 @@
+package starrconfig
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+
+	"golift.io/starr"
+)
+
+/* This file describes the resources in a document, and converts them between the
+ * API form (with instance IDs) and the document form (with names).
+ */
+
+// apiVer is the API version for both supported apps.
+const apiVer = "v3"
+
+// masked is the value the apps return in place of secrets.
+const masked = "********"
+
+// Paths of the resources in a document. These are also the kinds in a Change.
+const (
+	KindTag               = "tag"
+	KindCustomFormat      = "customformat"
+	KindQualityDefinition = "qualitydefinition"
+	KindQualityProfile    = "qualityprofile"
+	KindDelayProfile      = "delayprofile"
+	KindReleaseProfile    = "releaseprofile"
+	KindDownloadClient    = "downloadclient"
+	KindIndexer           = "indexer"
+	KindNotification      = "notification"
+	KindNaming            = "config/naming"
+	KindMediaManagement   = "config/mediaManagement"
+)
+
+// kind is a list of resources in a document.
+type kind struct {
+	path  string
+	list  func(doc *Document) *[]Object
+	key   func(obj Object) string // Used to match resources between instances.
+	fixed bool                    // Resources can only be updated, not created or deleted.
+	force bool                    // Send forceSave=true so a failed connection test does not block the change.
+}
+
+// kinds are in the order they're applied. Referenced resources come before the resources that use them.
+//
+//nolint:gochecknoglobals // this is a read-only table.
+var kinds = []*kind{
+	{path: KindCustomFormat, list: func(d *Document) *[]Object { return &d.CustomFormats }, key: nameKey},
+	{
+		path: KindQualityDefinition, list: func(d *Document) *[]Object { return &d.QualityDefinitions },
+		key: qualityKey, fixed: true,
+	},
+	{path: KindQualityProfile, list: func(d *Document) *[]Object { return &d.QualityProfiles }, key: nameKey},
+	{path: KindDelayProfile, list: func(d *Document) *[]Object { return &d.DelayProfiles }, key: tagsKey},
+	{
+		path: KindDownloadClient, list: func(d *Document) *[]Object { return &d.DownloadClients },
+		key: nameKey, force: true,
+	},
+	{path: KindIndexer, list: func(d *Document) *[]Object { return &d.Indexers }, key: nameKey, force: true},
+	{path: KindReleaseProfile, list: func(d *Document) *[]Object { return &d.ReleaseProfiles }, key: nameKey},
+	{
+		path: KindNotification, list: func(d *Document) *[]Object { return &d.Notifications },
+		key: nameKey, force: true,
+	},
+}
+
+// settings are single objects, in the order they're applied.
+//
+//nolint:gochecknoglobals // this is a read-only table.
+var settings = []struct {
+	path string
+	get  func(doc *Document) *Object
+}{
+	{path: KindNaming, get: func(d *Document) *Object { return &d.Naming }},
+	{path: KindMediaManagement, get: func(d *Document) *Object { return &d.MediaManagement }},
+}
+
+// readOnly keys are removed from exported resources. The apps ignore them on input.
+//
+//nolint:gochecknoglobals // this is a read-only list.
+var readOnly = []string{"id", "implementationName", "infoLink", "message", "presets"}
+
+// refs converts the IDs of referenced resources to names, and back.
+// These references are found by key anywhere in a resource's top level.
+type refs struct {
+	names map[string]map[int64]string // kind -> id -> name
+	ids   map[string]map[string]int64 // kind -> name -> id
+}
+
+// references maps a resource key to the kind it references, and the key it's stored as in a document.
+//
+//nolint:gochecknoglobals // this is a read-only table.
+var references = map[string]struct{ kind, docKey string }{
+	"downloadClientId": {KindDownloadClient, "downloadClient"},
+	"indexerId":        {KindIndexer, "indexer"},
+	"qualityProfileId": {KindQualityProfile, "qualityProfile"},
+}
+
+// byKey maps resources by their match key. Two resources with the same key cannot be told apart,
+// so that's an error. If convert is not nil, it's called on each resource before reading its key.
+func (k *kind) byKey(objs []Object, convert func(Object) Object) (map[string]Object, error) {
+	output := make(map[string]Object, len(objs))
+
+	for _, obj := range objs {
+		key := obj
+		if convert != nil {
+			key = convert(obj)
+		}
+
+		name := k.key(key)
+		if _, ok := output[name]; ok {
+			return nil, fmt.Errorf("%w: %s %q", ErrDuplicateName, k.path, name)
+		}
+
+		output[name] = obj
+	}
+
+	return output, nil
+}
+
+func nameKey(obj Object) string {
+	name, _ := obj["name"].(string)
+	return name
+}
+
+// qualityKey matches quality definitions by their quality's name.
+func qualityKey(obj Object) string {
+	quality, _ := obj["quality"].(map[string]any)
+	name, _ := quality["name"].(string)
+
+	return name
+}
+
+// tagsKey matches delay profiles by their tag labels. The default delay profile has no tags.
+func tagsKey(obj Object) string {
+	labels := []string{}
+
+	for _, tag := range toSlice(obj["tags"]) {
+		labels = append(labels, fmt.Sprint(tag))
+	}
+
+	slices.Sort(labels)
+
+	return "tags:" + strings.Join(labels, ",")
+}
+
+// loadRefs fetches every referenced resource from an instance.
+func loadRefs(ctx context.Context, api starr.APIer) (*refs, error) {
+	ref := &refs{names: make(map[string]map[int64]string), ids: make(map[string]map[string]int64)}
+
+	for _, kind := range []string{KindTag, KindCustomFormat, KindQualityProfile, KindDownloadClient, KindIndexer} {
+		list, err := getList(ctx, api, kind)
+		if err != nil {
+			return nil, err
+		}
+
+		ref.names[kind] = make(map[int64]string)
+		ref.ids[kind] = make(map[string]int64)
+
+		for _, obj := range list {
+			name := nameKey(obj)
+			if kind == KindTag {
+				name, _ = obj["label"].(string)
+			}
+
+			ref.add(kind, name, toInt64(obj["id"]))
+		}
+	}
+
+	return ref, nil
+}
+
+func (r *refs) add(kind, name string, id int64) {
+	if r.names[kind] == nil {
+		return // not a referenced kind.
+	}
+
+	r.names[kind][id] = name
+	r.ids[kind][name] = id
+}
+
+func (r *refs) remove(kind, name string) {
+	if r.ids[kind] == nil {
+		return
+	}
+
+	delete(r.names[kind], r.ids[kind][name])
+	delete(r.ids[kind], name)
+}
+
+// toDoc converts a resource from the API into document form.
+func (r *refs) toDoc(input Object) Object {
+	output := maps.Clone(input)
+
+	for _, key := range readOnly {
+		delete(output, key)
+	}
+
+	if tags, ok := output["tags"].([]any); ok {
+		labels := make([]any, 0, len(tags))
+		for _, tag := range tags {
+			labels = append(labels, r.names[KindTag][toInt64(tag)])
+		}
+
+		output["tags"] = labels
+	}
+
+	for key, ref := range references {
+		if id, ok := output[key]; ok {
+			delete(output, key)
+			output[ref.docKey] = r.names[ref.kind][toInt64(id)] // An empty name is ID 0: none, or any.
+		}
+	}
+
+	if items, ok := output["formatItems"].([]any); ok {
+		formats := make([]any, 0, len(items))
+
+		for _, item := range items {
+			if item, ok := item.(map[string]any); ok {
+				item = maps.Clone(item)
+				delete(item, "format")
+				formats = append(formats, item)
+			}
+		}
+
+		output["formatItems"] = formats
+	}
+
+	return reduceFields(output).(Object) //nolint:forcetypeassert // maps in, maps out.
+}
+
+// fromDoc converts a resource in document form into the API form. Missing tags are created with addTag.
+func (r *refs) fromDoc(input Object, addTag func(label string) (int64, error)) (Object, error) {
+	output := maps.Clone(input)
+
+	if labels, ok := output["tags"].([]any); ok {
+		tags := make([]any, 0, len(labels))
+
+		for _, label := range labels {
+			id, ok := r.ids[KindTag][fmt.Sprint(label)]
+			if !ok {
+				var err error
+				if id, err = addTag(fmt.Sprint(label)); err != nil {
+					return nil, err
+				}
+			}
+
+			tags = append(tags, id)
+		}
+
+		output["tags"] = tags
+	}
+
+	for key, ref := range references {
+		name, ok := output[ref.docKey].(string)
+		if !ok {
+			continue
+		}
+
+		delete(output, ref.docKey)
+
+		id, ok := r.ids[ref.kind][name]
+		if !ok && name != "" {
+			return nil, fmt.Errorf("%w: %s %q", ErrMissingReference, ref.kind, name)
+		}
+
+		output[key] = id
+	}
+
+	if items, ok := output["formatItems"].([]any); ok {
+		formats := make([]any, 0, len(items))
+
+		for _, item := range items {
+			item, _ := item.(map[string]any)
+			name := nameKey(item)
+
+			id, ok := r.ids[KindCustomFormat][name]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s %q", ErrMissingReference, KindCustomFormat, name)
+			}
+
+			item = maps.Clone(item)
+			item["format"] = id
+			formats = append(formats, item)
+		}
+
+		output["formatItems"] = formats
+	}
+
+	return output, nil
+}
+
+// reduceFields replaces every "fields" list with just the field names and values.
+// The apps return labels, help text and select options in each field, but only need the values.
+func reduceFields(input any) any {
+	switch input := input.(type) {
+	case map[string]any:
+		output := make(map[string]any, len(input))
+
+		for key, val := range input {
+			if fields, ok := val.([]any); ok && key == "fields" {
+				output[key] = reduceFieldList(fields)
+			} else {
+				output[key] = reduceFields(val)
+			}
+		}
+
+		return output
+	case []any:
+		output := make([]any, len(input))
+		for idx, val := range input {
+			output[idx] = reduceFields(val)
+		}
+
+		return output
+	default:
+		return input
+	}
+}
+
+func reduceFieldList(fields []any) []any {
+	output := make([]any, 0, len(fields))
+
+	for _, field := range fields {
+		field, ok := field.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		reduced := map[string]any{"name": field["name"]}
+		if val, ok := field["value"]; ok {
+			reduced["value"] = val
+		}
+
+		output = append(output, reduced)
+	}
+
+	return output
+}
+
+// matches returns true if every value in want is the same in have. Keys only in have are ignored,
+// and a masked secret in want matches any value.
+func matches(want, have any) bool {
+	switch want := want.(type) {
+	case map[string]any:
+		have, ok := have.(map[string]any)
+		if !ok {
+			return false
+		}
+
+		for key, val := range want {
+			if !matches(val, have[key]) {
+				return false
+			}
+		}
+
+		return true
+	case []any:
+		have, ok := have.([]any)
+		if !ok || len(want) != len(have) {
+			return len(want) == 0 && have == nil
+		}
+
+		for idx := range want {
+			if !matches(want[idx], have[idx]) {
+				return false
+			}
+		}
+
+		return true
+	case string:
+		return want == masked || want == have
+	case nil:
+		return have == nil
+	default:
+		return fmt.Sprint(want) == fmt.Sprint(have)
+	}
+}
+
+func toInt64(value any) int64 {
+	switch value := value.(type) {
+	case float64:
+		return int64(value)
+	case int64:
+		return value
+	case int:
+		return int64(value)
+	default:
+		return 0
+	}
+}
+
+func toSlice(value any) []any {
+	slice, _ := value.([]any)
+	return slice
+}
+
+func uri(parts ...string) string {
+	return path.Join(append([]string{apiVer}, parts...)...)
+}
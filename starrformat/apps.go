@@ -0,0 +1,105 @@
+package starrformat
+
+import (
+	"encoding/json"
+
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/sonarr"
+)
+
+// Source and modifier values used by the apps' source and quality modifier specifications.
+// The parse endpoints return these names in a quality's source and modifier.
+//
+//nolint:gochecknoglobals,mnd // these are read-only enum tables.
+var (
+	radarrSources = map[string]int64{
+		"unknown": 0, "cam": 1, "telesync": 2, "telecine": 3, "workprint": 4,
+		"dvd": 5, "tv": 6, "webdl": 7, "webrip": 8, "bluray": 9,
+	}
+	radarrModifiers = map[string]int64{
+		"none": 0, "regional": 1, "screener": 2, "rawhd": 3, "brdisk": 4, "remux": 5,
+	}
+	sonarrSources = map[string]int64{
+		"unknown": 0, "television": 1, "televisionRaw": 2, "web": 3,
+		"webRip": 4, "dvd": 5, "bluray": 6, "blurayRaw": 7,
+	}
+)
+
+// NewRadarr returns an evaluator for Radarr custom formats, like those from GetCustomFormats.
+func NewRadarr(formats []*radarr.CustomFormatOutput) *Evaluator {
+	evaluator := &Evaluator{sources: radarrSources, modifiers: radarrModifiers}
+
+	for _, input := range formats {
+		format := &format{id: input.ID, name: input.Name}
+
+		for _, spec := range input.Specifications {
+			format.add(spec.Implementation, spec.Negate, spec.Required, spec.Fields)
+		}
+
+		evaluator.formats = append(evaluator.formats, format)
+	}
+
+	return evaluator
+}
+
+// NewSonarr returns an evaluator for Sonarr custom formats, like those from GetCustomFormats.
+func NewSonarr(formats []*sonarr.CustomFormatOutput) *Evaluator {
+	evaluator := &Evaluator{sources: sonarrSources}
+
+	for _, input := range formats {
+		format := &format{id: input.ID, name: input.Name}
+
+		for _, spec := range input.Specifications {
+			format.add(spec.Implementation, spec.Negate, spec.Required, spec.Fields)
+		}
+
+		evaluator.formats = append(evaluator.formats, format)
+	}
+
+	return evaluator
+}
+
+// RadarrRelease returns the release from a Radarr parse result. Set the size and indexer flags yourself.
+func RadarrRelease(parse *radarr.ParseOutput) *Release {
+	var info struct {
+		Quality      *starr.Quality `json:"quality"`
+		Languages    []*starr.Value `json:"languages"`
+		ReleaseGroup string         `json:"releaseGroup"`
+	}
+
+	// The parsed info is a decoded JSON object, so this cannot fail in a way that matters.
+	if data, err := json.Marshal(parse.ParsedMovieInfo); err == nil {
+		_ = json.Unmarshal(data, &info)
+	}
+
+	release := &Release{Title: parse.Title, Languages: parse.Languages, ReleaseGroup: info.ReleaseGroup}
+	if len(release.Languages) == 0 {
+		release.Languages = info.Languages
+	}
+
+	if info.Quality != nil {
+		release.Quality = info.Quality.Quality
+	}
+
+	return release
+}
+
+// SonarrRelease returns the release from a Sonarr parse result. Set the size and indexer flags yourself.
+func SonarrRelease(parse *sonarr.ParseOutput) *Release {
+	release := &Release{Title: parse.Title, Languages: parse.Languages}
+
+	if info := parse.ParsedEpisodeInfo; info != nil {
+		release.ReleaseGroup = info.ReleaseGroup
+
+		if len(release.Languages) == 0 {
+			release.Languages = info.Languages
+		}
+
+		if info.Quality != nil {
+			release.Quality = info.Quality.Quality
+		}
+	}
+
+	return release
+}
@@ -0,0 +1,252 @@
+// Package starrformat checks release titles against Sonarr and Radarr custom formats without a server.
+// Use it to see which formats a release matches, and what score it gets in a quality profile,
+// while tuning format specifications and scores.
+//
+// The apps use .NET regular expressions. Patterns that Go's regexp package cannot compile
+// (like lookbehinds) make their format unsupported; those formats are listed in each Result.
+package starrformat
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"golift.io/starr"
+)
+
+// Custom format specification implementations supported by the evaluator.
+const (
+	SpecReleaseTitle    = "ReleaseTitleSpecification"
+	SpecSource          = "SourceSpecification"
+	SpecResolution      = "ResolutionSpecification"
+	SpecSize            = "SizeSpecification"
+	SpecLanguage        = "LanguageSpecification"
+	SpecIndexerFlag     = "IndexerFlagSpecification"
+	SpecReleaseGroup    = "ReleaseGroupSpecification"
+	SpecQualityModifier = "QualityModifierSpecification" // Radarr only.
+)
+
+// ErrUnsupported is wrapped in the reasons a format cannot be evaluated.
+var ErrUnsupported = errors.New("unsupported specification")
+
+// gigabyte is the unit of the size specification's min and max fields.
+const gigabyte = 1024 * 1024 * 1024
+
+// Release is what custom formats are checked against. Only Title is required.
+// Fill in the rest from a parse result, with RadarrRelease or SonarrRelease.
+type Release struct {
+	Title        string
+	Quality      *starr.BaseQuality // Source, resolution and modifier, as the apps return them.
+	Size         int64              // In bytes.
+	Languages    []*starr.Value
+	IndexerFlags int64 // Bit flags, like the indexerFlags on a release.
+	ReleaseGroup string
+}
+
+// Evaluator checks releases against a set of custom formats from one app.
+// Create one with NewRadarr or NewSonarr.
+type Evaluator struct {
+	formats   []*format
+	sources   map[string]int64
+	modifiers map[string]int64
+}
+
+// Result is the outcome of one evaluation.
+type Result struct {
+	// Matches are the IDs of the formats the release matched, in the order the formats were provided.
+	Matches []int64
+	// Names of the matched formats, in the same order.
+	Names []string
+	// Score is the total score of the matched formats in the quality profile's format items.
+	Score int64
+	// Unsupported contains the reason each format could not be evaluated, by format name.
+	Unsupported map[string]error
+}
+
+type format struct {
+	id    int64
+	name  string
+	specs []*spec
+	err   error // Set if the format cannot be evaluated.
+}
+
+type spec struct {
+	implementation string
+	negate         bool
+	required       bool
+	fields         map[string]any
+	pattern        *regexp.Regexp
+}
+
+// add compiles a specification into the format. Once one fails, the format is unsupported.
+func (f *format) add(implementation string, negate, required bool, fields []*starr.FieldOutput) {
+	if f.err != nil {
+		return
+	}
+
+	spec, err := newSpec(implementation, negate, required, fields)
+	if err != nil {
+		f.err = err
+		return
+	}
+
+	f.specs = append(f.specs, spec)
+}
+
+// newSpec compiles a specification's pattern, if it has one.
+func newSpec(implementation string, negate, required bool, fields []*starr.FieldOutput) (*spec, error) {
+	output := &spec{
+		implementation: implementation,
+		negate:         negate,
+		required:       required,
+		fields:         make(map[string]any, len(fields)),
+	}
+
+	for _, field := range fields {
+		output.fields[field.Name] = field.Value
+	}
+
+	switch implementation {
+	case SpecReleaseTitle, SpecReleaseGroup:
+		var err error
+		// The apps match case-insensitively.
+		if output.pattern, err = regexp.Compile("(?i)" + fmt.Sprint(output.fields["value"])); err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrUnsupported, implementation, err)
+		}
+	case SpecSource, SpecResolution, SpecSize, SpecLanguage, SpecIndexerFlag, SpecQualityModifier:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupported, implementation)
+	}
+
+	return output, nil
+}
+
+// Evaluate checks a release against every format, and totals the scores of
+// the matched formats from a quality profile's format items. Items may be nil.
+func (e *Evaluator) Evaluate(release *Release, items []*starr.FormatItem) *Result {
+	result := &Result{Matches: []int64{}, Names: []string{}, Unsupported: make(map[string]error)}
+
+	for _, format := range e.formats {
+		if format.err != nil {
+			result.Unsupported[format.name] = format.err
+			continue
+		}
+
+		if !e.matches(format, release) {
+			continue
+		}
+
+		result.Matches = append(result.Matches, format.id)
+		result.Names = append(result.Names, format.name)
+
+		for _, item := range items {
+			if item.Format == format.id {
+				result.Score += item.Score
+			}
+		}
+	}
+
+	return result
+}
+
+// EvaluateTitle checks a bare release title. Only title patterns can match a title by itself,
+// so parse the title first (with starrparse, or the app's parse endpoint) and use Evaluate
+// to check the other specifications.
+func (e *Evaluator) EvaluateTitle(title string, items []*starr.FormatItem) *Result {
+	return e.Evaluate(&Release{Title: title}, items)
+}
+
+// matches works like the apps: specifications are grouped by implementation, and every group must match.
+// A group matches when none of its required specifications fail, and at least one specification passes.
+func (e *Evaluator) matches(format *format, release *Release) bool {
+	passed := make(map[string]bool)
+
+	for _, spec := range format.specs {
+		ok := e.satisfied(spec, release)
+		if spec.required && !ok {
+			return false
+		}
+
+		passed[spec.implementation] = passed[spec.implementation] || ok
+	}
+
+	for _, ok := range passed {
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// satisfied returns true if a specification matches a release, after negation.
+func (e *Evaluator) satisfied(spec *spec, release *Release) bool {
+	quality := release.Quality
+	if quality == nil {
+		quality = &starr.BaseQuality{}
+	}
+
+	value := toInt64(spec.fields["value"])
+	match := false
+
+	switch spec.implementation {
+	case SpecReleaseTitle:
+		match = spec.pattern.MatchString(release.Title)
+	case SpecReleaseGroup:
+		match = release.ReleaseGroup != "" && spec.pattern.MatchString(release.ReleaseGroup)
+	case SpecSource:
+		source, ok := e.sources[quality.Source]
+		match = ok && source == value
+	case SpecQualityModifier:
+		modifier, ok := e.modifiers[quality.Modifier]
+		match = ok && modifier == value
+	case SpecResolution:
+		match = int64(quality.Resolution) == value
+	case SpecSize:
+		size := float64(release.Size) / gigabyte
+		match = size > toFloat64(spec.fields["min"]) && size <= toFloat64(spec.fields["max"])
+	case SpecLanguage:
+		match = language(release.Languages, value, spec.fields["exceptLanguage"] == true)
+	case SpecIndexerFlag:
+		match = value != 0 && release.IndexerFlags&value == value
+	}
+
+	return match != spec.negate
+}
+
+// language returns true if the release has the language, or with except, any other language.
+func language(languages []*starr.Value, id int64, except bool) bool {
+	for _, lang := range languages {
+		if (lang.ID == id) != except {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toInt64(value any) int64 {
+	switch value := value.(type) {
+	case float64:
+		return int64(value)
+	case int64:
+		return value
+	case int:
+		return int64(value)
+	default:
+		return 0
+	}
+}
+
+func toFloat64(value any) float64 {
+	switch value := value.(type) {
+	case float64:
+		return value
+	case int64:
+		return float64(value)
+	case int:
+		return float64(value)
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,155 @@
+package starrformat_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrformat"
+)
+
+func value(val any) []*starr.FieldOutput {
+	return []*starr.FieldOutput{{Name: "value", Value: val}}
+}
+
+//nolint:gochecknoglobals // test data.
+var radarrFormats = []*radarr.CustomFormatOutput{
+	{ID: 1, Name: "x265 (HD)", Specifications: []*radarr.CustomFormatOutputSpec{
+		{Implementation: starrformat.SpecReleaseTitle, Required: true, Fields: value(`[xh][ ._-]?265|\bHEVC(\b|\d)`)},
+		{Implementation: starrformat.SpecResolution, Negate: true, Required: true, Fields: value(float64(2160))},
+	}},
+	{ID: 2, Name: "Remux", Specifications: []*radarr.CustomFormatOutputSpec{
+		{Implementation: starrformat.SpecQualityModifier, Fields: value(5)},
+	}},
+	{ID: 3, Name: "HD Bluray or WEB", Specifications: []*radarr.CustomFormatOutputSpec{
+		{Implementation: starrformat.SpecSource, Fields: value(9)},
+		{Implementation: starrformat.SpecSource, Fields: value(7)},
+		{Implementation: starrformat.SpecResolution, Required: true, Fields: value(1080)},
+	}},
+	{ID: 4, Name: "Big", Specifications: []*radarr.CustomFormatOutputSpec{{
+		Implementation: starrformat.SpecSize,
+		Fields:         []*starr.FieldOutput{{Name: "min", Value: 10}, {Name: "max", Value: 100.5}},
+	}}},
+	{ID: 5, Name: "Not French", Specifications: []*radarr.CustomFormatOutputSpec{{
+		Implementation: starrformat.SpecLanguage, Negate: true,
+		Fields: []*starr.FieldOutput{{Name: "value", Value: 2}, {Name: "exceptLanguage", Value: false}},
+	}}},
+	{ID: 6, Name: "Freeleech", Specifications: []*radarr.CustomFormatOutputSpec{
+		{Implementation: starrformat.SpecIndexerFlag, Fields: value(1)},
+	}},
+	{ID: 7, Name: "Bad Group", Specifications: []*radarr.CustomFormatOutputSpec{
+		{Implementation: starrformat.SpecReleaseGroup, Fields: value(`^(YIFY|YTS)$`)},
+	}},
+	{ID: 8, Name: "Lookbehind", Specifications: []*radarr.CustomFormatOutputSpec{
+		{Implementation: starrformat.SpecReleaseTitle, Fields: value(`(?<!DV)HDR`)},
+	}},
+	{ID: 9, Name: "Edition", Specifications: []*radarr.CustomFormatOutputSpec{
+		{Implementation: "EditionSpecification", Fields: value("IMAX")},
+	}},
+}
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	evaluator := starrformat.NewRadarr(radarrFormats)
+	items := []*starr.FormatItem{{Format: 1, Score: -10000}, {Format: 3, Score: 50}, {Format: 6, Score: 5}}
+
+	tests := []struct {
+		name    string
+		release *starrformat.Release
+		matches []int64
+		score   int64
+	}{
+		{
+			name:    "title only",
+			release: &starrformat.Release{Title: "Movie.2020.1080p.WEB-DL.x265-GROUP"},
+			matches: []int64{1, 5},
+			score:   -10000,
+		},
+		{
+			name: "4k hevc",
+			release: &starrformat.Release{
+				Title:   "Movie.2020.2160p.UHD.BluRay.REMUX.HEVC-GROUP",
+				Quality: &starr.BaseQuality{Source: "bluray", Resolution: 2160, Modifier: "remux"},
+				Size:    60 * 1024 * 1024 * 1024,
+			},
+			matches: []int64{2, 4, 5},
+		},
+		{
+			name: "hd web",
+			release: &starrformat.Release{
+				Title:        "Movie.2020.1080p.WEB-DL.DDP5.1.H.264-YTS",
+				Quality:      &starr.BaseQuality{Source: "webdl", Resolution: 1080},
+				Languages:    []*starr.Value{{ID: 2, Name: "French"}},
+				IndexerFlags: 1 | 8,
+				ReleaseGroup: "yts",
+				Size:         5 * 1024 * 1024 * 1024,
+			},
+			matches: []int64{3, 6, 7},
+			score:   55,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := evaluator.Evaluate(test.release, items)
+			assert.Equal(t, test.matches, result.Matches)
+			assert.Equal(t, test.score, result.Score)
+			assert.Len(t, result.Unsupported, 2)
+			require.ErrorIs(t, result.Unsupported["Lookbehind"], starrformat.ErrUnsupported)
+			require.ErrorIs(t, result.Unsupported["Edition"], starrformat.ErrUnsupported)
+		})
+	}
+}
+
+func TestSonarrRelease(t *testing.T) {
+	t.Parallel()
+
+	evaluator := starrformat.NewSonarr([]*sonarr.CustomFormatOutput{
+		{ID: 10, Name: "WEB", Specifications: []*sonarr.CustomFormatOutputSpec{
+			{Implementation: starrformat.SpecSource, Fields: value(3)},
+		}},
+		{ID: 11, Name: "Bluray", Specifications: []*sonarr.CustomFormatOutputSpec{
+			{Implementation: starrformat.SpecSource, Fields: value(6)},
+		}},
+	})
+
+	release := starrformat.SonarrRelease(&sonarr.ParseOutput{
+		Title: "Show.S01E01.1080p.WEB-DL-GROUP",
+		ParsedEpisodeInfo: &sonarr.ParsedEpisodeInfo{
+			ReleaseGroup: "GROUP",
+			Quality:      &starr.Quality{Quality: &starr.BaseQuality{Source: "web", Resolution: 1080}},
+		},
+	})
+
+	result := evaluator.Evaluate(release, []*starr.FormatItem{{Format: 10, Score: 100}})
+	assert.Equal(t, []string{"WEB"}, result.Names)
+	assert.Equal(t, int64(100), result.Score)
+}
+
+func TestRadarrRelease(t *testing.T) {
+	t.Parallel()
+
+	release := starrformat.RadarrRelease(&radarr.ParseOutput{
+		Title: "Movie.2020.1080p.BluRay-GROUP",
+		ParsedMovieInfo: map[string]any{
+			"releaseGroup": "GROUP",
+			"languages":    []any{map[string]any{"id": 1, "name": "English"}},
+			"quality": map[string]any{
+				"quality": map[string]any{"id": 7, "name": "Bluray-1080p", "source": "bluray", "resolution": 1080},
+			},
+		},
+	})
+
+	assert.Equal(t, &starrformat.Release{
+		Title:        "Movie.2020.1080p.BluRay-GROUP",
+		Quality:      &starr.BaseQuality{ID: 7, Name: "Bluray-1080p", Source: "bluray", Resolution: 1080},
+		Languages:    []*starr.Value{{ID: 1, Name: "English"}},
+		ReleaseGroup: "GROUP",
+	}, release)
+}
@@ -0,0 +1,76 @@
+package starrparse
+
+import (
+	"regexp"
+	"strconv"
+)
+
+//nolint:gochecknoglobals // these are compiled once.
+var (
+	// S01E01, S01E01E02, S01E01-E03 and S01E01-03.
+	standardRegex = regexp.MustCompile(`(?i)\bS(\d{1,2})[. ]?E(\d{1,4})((?:-E?\d{1,4}\b|[. ]?E\d{1,4}\b)*)`)
+	moreRegex     = regexp.MustCompile(`(?i)(-)?[. ]?E?(\d{1,4})`)
+	// 1x01 and 1x01-1x02.
+	crossRegex = regexp.MustCompile(`(?i)\b(\d{1,2})x(\d{2,3})(?:-(?:\d{1,2}x)?(\d{2,3}))?\b`)
+	// S01, Season 1 and Season.1 with no episode.
+	seasonRegex = regexp.MustCompile(`(?i)\b(?:S(\d{1,2})|Season[. ](\d{1,2}))\b`)
+	// [Group] Title - 01 [1080p] and [Group] Title - 01v2.
+	absoluteRegex = regexp.MustCompile(`^\[[^\]]+\].+?\s-\s(\d{2,4})(?:v\d)?(?:-(\d{2,4}))?\b`)
+)
+
+// parseEpisodes finds season and episode numbers.
+func (r *Release) parseEpisodes(title string) {
+	if match := standardRegex.FindStringSubmatch(title); match != nil {
+		r.SeasonNumber, _ = strconv.Atoi(match[1])
+		first, _ := strconv.Atoi(match[2])
+		r.EpisodeNumbers = []int{first}
+
+		for _, more := range moreRegex.FindAllStringSubmatch(match[3], -1) {
+			next, _ := strconv.Atoi(more[2])
+			if more[1] == "-" {
+				r.EpisodeNumbers = episodeRange(r.EpisodeNumbers[len(r.EpisodeNumbers)-1], next, r.EpisodeNumbers)
+			} else if next > r.EpisodeNumbers[len(r.EpisodeNumbers)-1] {
+				r.EpisodeNumbers = append(r.EpisodeNumbers, next)
+			}
+		}
+
+		return
+	}
+
+	if match := crossRegex.FindStringSubmatch(title); match != nil {
+		r.SeasonNumber, _ = strconv.Atoi(match[1])
+		first, _ := strconv.Atoi(match[2])
+		r.EpisodeNumbers = []int{first}
+
+		if last, err := strconv.Atoi(match[3]); err == nil {
+			r.EpisodeNumbers = episodeRange(first, last, r.EpisodeNumbers)
+		}
+
+		return
+	}
+
+	if match := absoluteRegex.FindStringSubmatch(title); match != nil {
+		first, _ := strconv.Atoi(match[1])
+		r.AbsoluteEpisodeNumbers = []int{first}
+
+		if last, err := strconv.Atoi(match[2]); err == nil {
+			r.AbsoluteEpisodeNumbers = episodeRange(first, last, r.AbsoluteEpisodeNumbers)
+		}
+
+		return
+	}
+
+	if match := seasonRegex.FindStringSubmatch(title); match != nil {
+		r.SeasonNumber, _ = strconv.Atoi(match[1] + match[2])
+		r.FullSeason = true
+	}
+}
+
+// episodeRange appends the episodes after from, up to and including to.
+func episodeRange(from, to int, episodes []int) []int {
+	for episode := from + 1; episode <= to; episode++ {
+		episodes = append(episodes, episode)
+	}
+
+	return episodes
+}
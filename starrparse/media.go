@@ -0,0 +1,130 @@
+package starrparse
+
+import (
+	"regexp"
+
+	"golift.io/starr"
+)
+
+// The first match in each table wins.
+//
+//nolint:gochecknoglobals // these are compiled once.
+var (
+	codecs = []struct {
+		name  string
+		regex *regexp.Regexp
+	}{
+		{"x265", regexp.MustCompile(`(?i)\b(?:x265|h\.?265|hevc)\b`)},
+		{"x264", regexp.MustCompile(`(?i)\b(?:x264|h\.?264|avc)\b`)},
+		{"AV1", regexp.MustCompile(`(?i)\bav1\b`)},
+		{"VP9", regexp.MustCompile(`(?i)\bvp9\b`)},
+		{"XviD", regexp.MustCompile(`(?i)\b(?:xvid|divx)\b`)},
+		{"VC-1", regexp.MustCompile(`(?i)\bvc-?1\b`)},
+		{"MPEG2", regexp.MustCompile(`(?i)\bmpeg-?2\b`)},
+	}
+	audios = []struct {
+		name  string
+		regex *regexp.Regexp
+	}{
+		{"TrueHD", regexp.MustCompile(`(?i)\btrue-?hd`)},
+		{"DTS-X", regexp.MustCompile(`(?i)\bdts[-. ]?x\b`)},
+		{"DTS-HD MA", regexp.MustCompile(`(?i)\bdts[-. ]?(?:hd[-. ]?)?ma\b`)},
+		{"DTS-HD", regexp.MustCompile(`(?i)\bdts[-. ]?hd`)},
+		{"DTS", regexp.MustCompile(`(?i)\bdts`)},
+		{"EAC3", regexp.MustCompile(`(?i)\b(?:ddp|dd\+|e-?ac-?3)`)},
+		{"AC3", regexp.MustCompile(`(?i)\b(?:dd|ac-?3|dolby[. ]?digital)(?:\d|\b)`)},
+		{"AAC", regexp.MustCompile(`(?i)\baac`)},
+		{"FLAC", regexp.MustCompile(`(?i)\bflac`)},
+		{"Opus", regexp.MustCompile(`(?i)\bopus\b`)},
+		{"MP3", regexp.MustCompile(`(?i)\bmp3\b`)},
+	}
+	atmosRegex    = regexp.MustCompile(`(?i)\batmos\b`)
+	channelsRegex = regexp.MustCompile(
+		`(?i)(?:ddp|dd\+|dd|aac|ac3|eac3|dts|truehd|atmos|flac|opus|ma|x)[-. ]?([1-9])[. ]?([01])\b`)
+	// Language IDs are the same in Sonarr and Radarr.
+	languages = []struct {
+		language starr.Value
+		regex    *regexp.Regexp
+	}{
+		{starr.Value{ID: 2, Name: "French"}, regexp.MustCompile(`(?i)\b(?:french|truefrench|vostfr|vff|vfq|vf2)\b`)},
+		{starr.Value{ID: 3, Name: "Spanish"}, regexp.MustCompile(`(?i)\b(?:spanish|espanol|castellano)\b`)},
+		{starr.Value{ID: 4, Name: "German"}, regexp.MustCompile(`(?i)\b(?:german|deutsch|videomann)\b`)},
+		{starr.Value{ID: 5, Name: "Italian"}, regexp.MustCompile(`(?i)\b(?:italian|ita)\b`)},
+		{starr.Value{ID: 6, Name: "Danish"}, regexp.MustCompile(`(?i)\b(?:danish|dk)\b`)},
+		{starr.Value{ID: 7, Name: "Dutch"}, regexp.MustCompile(`(?i)\b(?:dutch|nl)\b`)},
+		{starr.Value{ID: 8, Name: "Japanese"}, regexp.MustCompile(`(?i)\b(?:japanese|jap)\b`)},
+		{starr.Value{ID: 10, Name: "Chinese"}, regexp.MustCompile(`(?i)\b(?:chinese|chs|cht)\b`)},
+		{starr.Value{ID: 11, Name: "Russian"}, regexp.MustCompile(`(?i)\b(?:russian|rus)\b`)},
+		{starr.Value{ID: 12, Name: "Polish"}, regexp.MustCompile(`(?i)\b(?:polish|pldub)\b`)},
+		{starr.Value{ID: 14, Name: "Swedish"}, regexp.MustCompile(`(?i)\b(?:swedish|swesub)\b`)},
+		{starr.Value{ID: 15, Name: "Norwegian"}, regexp.MustCompile(`(?i)\b(?:norwegian|nordic)\b`)},
+		{starr.Value{ID: 16, Name: "Finnish"}, regexp.MustCompile(`(?i)\bfinnish\b`)},
+		{starr.Value{ID: 17, Name: "Turkish"}, regexp.MustCompile(`(?i)\bturkish\b`)},
+		{starr.Value{ID: 18, Name: "Portuguese"}, regexp.MustCompile(`(?i)\bportuguese\b`)},
+		{starr.Value{ID: 21, Name: "Korean"}, regexp.MustCompile(`(?i)\bkorean\b`)},
+		{starr.Value{ID: 22, Name: "Hungarian"}, regexp.MustCompile(`(?i)\b(?:hungarian|hun)\b`)},
+		{starr.Value{ID: 23, Name: "Hebrew"}, regexp.MustCompile(`(?i)\bhebrew\b`)},
+		{starr.Value{ID: 25, Name: "Czech"}, regexp.MustCompile(`(?i)\bczech\b`)},
+		{starr.Value{ID: 26, Name: "Hindi"}, regexp.MustCompile(`(?i)\bhindi\b`)},
+	}
+	englishRegex = regexp.MustCompile(`(?i)\b(?:english|eng)\b`)
+)
+
+func parseCodec(title string) string {
+	for _, codec := range codecs {
+		if codec.regex.MatchString(title) {
+			return codec.name
+		}
+	}
+
+	return ""
+}
+
+// parseAudio returns the audio format and channels.
+func parseAudio(title string) (string, string) {
+	audio := ""
+
+	for _, a := range audios {
+		if a.regex.MatchString(title) {
+			audio = a.name
+			break
+		}
+	}
+
+	if atmosRegex.MatchString(title) {
+		if audio == "" {
+			audio = "Atmos"
+		} else {
+			audio += " Atmos"
+		}
+	}
+
+	channels := ""
+	if match := channelsRegex.FindStringSubmatch(title); match != nil {
+		channels = match[1] + "." + match[2]
+	}
+
+	return audio, channels
+}
+
+// parseLanguages returns the languages in a title. English is assumed when none are found,
+// like the apps do. A title that names English with another language has both.
+func parseLanguages(title string) []*starr.Value {
+	found := []*starr.Value{}
+
+	if englishRegex.MatchString(title) {
+		found = append(found, &starr.Value{ID: 1, Name: "English"})
+	}
+
+	for _, lang := range languages {
+		if lang.regex.MatchString(title) {
+			found = append(found, &starr.Value{ID: lang.language.ID, Name: lang.language.Name})
+		}
+	}
+
+	if len(found) == 0 {
+		return []*starr.Value{{ID: 1, Name: "English"}}
+	}
+
+	return found
+}
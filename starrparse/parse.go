@@ -0,0 +1,184 @@
+// Package starrparse reads release titles without a server. It finds the same things the
+// Sonarr and Radarr parse endpoints do: quality (source, resolution and modifier), revision
+// (PROPER, REPACK), release group, season and episode numbers, year and languages.
+// It also finds the video codec and audio format, which the apps only use in custom formats.
+//
+// Qualities are returned in the starr.Quality shape each app uses, so they can be compared
+// with the output of sonarr.Parse and radarr.Parse. The parser follows the common naming
+// conventions in scene and P2P releases; titles that break them may parse differently than
+// they would in the apps.
+package starrparse
+
+import (
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golift.io/starr"
+)
+
+// Release is a parsed release title.
+type Release struct {
+	// ReleaseTitle is the input, without a file extension.
+	ReleaseTitle string
+	// Title is the series or movie title, with dots and underscores replaced by spaces.
+	Title string
+	// Year is the movie (or series) year, if the title has one.
+	Year int
+	// Quality is in the same shape the app's parse endpoint returns. It's Unknown (ID 0) when
+	// the title does not have a source or resolution. The revision has PROPER and REPACK info.
+	Quality *starr.Quality
+	// Source, Resolution and Modifier are what was found in the title, before they became a Quality.
+	Source     string
+	Resolution int
+	Modifier   string
+	// Codec is the video codec, like x264, x265, AV1, XviD, VC-1 or MPEG2.
+	Codec string
+	// Audio is the audio format, like AAC, AC3, EAC3, DTS, DTS-HD MA, TrueHD or FLAC.
+	// Atmos is added when the title has it, like "TrueHD Atmos".
+	Audio string
+	// AudioChannels are like 2.0, 5.1 or 7.1.
+	AudioChannels string
+	ReleaseGroup  string
+	// Languages are the spoken languages. Titles without a language are English.
+	Languages []*starr.Value
+	// These are only found in episode titles.
+	SeasonNumber           int
+	EpisodeNumbers         []int
+	AbsoluteEpisodeNumbers []int
+	FullSeason             bool
+}
+
+// Unknown qualities for each app.
+//
+//nolint:gochecknoglobals // these are read-only.
+var (
+	sonarrUnknown = starr.BaseQuality{Name: "Unknown", Source: "unknown"}
+	radarrUnknown = starr.BaseQuality{Name: "Unknown", Source: "unknown", Modifier: "none"}
+)
+
+//nolint:gochecknoglobals // these are compiled once.
+var (
+	extensionRegex = regexp.MustCompile(`(?i)\.(?:mkv|mp4|avi|m4v|wmv|ts|m2ts|nzb|torrent)$`)
+	yearRegex      = regexp.MustCompile(`\b(19[0-9]{2}|20[0-9]{2})\b`)
+	properRegex    = regexp.MustCompile(`(?i)\b(?:proper|rerip)\b`)
+	repackRegex    = regexp.MustCompile(`(?i)\brepack(\d)?\b`)
+	versionRegex   = regexp.MustCompile(`(?i)\bv([2-9])\b|\d(?:v|\.v)([2-9])\b`)
+	realRegex      = regexp.MustCompile(`\bREAL\b`)
+	// Group is after the last dash, or in brackets at the start (anime) or end of a title.
+	groupRegex      = regexp.MustCompile(`-([a-zA-Z0-9]+)(?:\[[^\]]+\])?$`)
+	animeGroupRegex = regexp.MustCompile(`^\[([^\]]+)\]`)
+	bracketGroup    = regexp.MustCompile(`\[([a-zA-Z0-9]+)\]$`)
+	notGroups       = []string{"dl", "rip", "ray", "hd", "x264", "x265", "h264", "h265", "web", "dts", "dd"}
+	titleSplitRegex = regexp.MustCompile(`(?i)\b(?:19[0-9]{2}|20[0-9]{2}|s\d{1,2}(?:[. ]?e\d{1,4})*|\d{1,2}x\d{2,3}` +
+		`|season[. ]\d|2160p|1080[pi]|720p|576p|480p|4k|web|webrip|web-dl|bluray|blu-ray|hdtv|dvd(?:rip)?` +
+		`|remux|proper|repack)\b`)
+	animeTitleRegex = regexp.MustCompile(`^\[[^\]]+\]\s*(.+?)\s+-\s+\d{2,4}\b`)
+)
+
+// Parse reads a release title, or a file name. The app selects the quality definitions; pass starr.Sonarr
+// or starr.Radarr. Other apps use music and book qualities, so their Quality is nil.
+func Parse(app starr.App, title string) *Release {
+	title = extensionRegex.ReplaceAllString(path.Base(strings.TrimSpace(title)), "")
+	// Word boundaries in Go regular expressions include underscores, so treat them like dots.
+	clean := strings.ReplaceAll(title, "_", ".")
+
+	release := &Release{ReleaseTitle: title}
+	release.Source, release.Resolution, release.Modifier = parseSource(clean)
+	release.Codec = parseCodec(clean)
+	release.Audio, release.AudioChannels = parseAudio(clean)
+	release.Languages = parseLanguages(clean)
+	release.ReleaseGroup = parseGroup(clean)
+	release.parseEpisodes(clean)
+	release.parseTitle(clean)
+
+	switch app {
+	case starr.Sonarr:
+		release.Quality = release.quality(sonarrQualities, sonarrUnknown, clean)
+	case starr.Radarr:
+		release.Quality = release.quality(radarrQualities, radarrUnknown, clean)
+	default:
+	}
+
+	return release
+}
+
+func (r *Release) quality(table []*appQuality, unknown starr.BaseQuality, title string) *starr.Quality {
+	output := &starr.Quality{
+		Quality:  quality(table, title, r.Source, r.Resolution, r.Modifier),
+		Revision: &starr.QualityRevision{Version: 1},
+	}
+
+	if output.Quality == nil {
+		output.Quality = &unknown
+	}
+
+	if properRegex.MatchString(title) {
+		output.Revision.Version = 2
+	}
+
+	if match := repackRegex.FindStringSubmatch(title); match != nil {
+		output.Revision.Version, output.Revision.IsRepack = 2, true
+
+		if version, _ := strconv.ParseInt(match[1], 10, 64); version > 1 {
+			output.Revision.Version = version
+		}
+	}
+
+	if match := versionRegex.FindStringSubmatch(title); match != nil {
+		output.Revision.Version, _ = strconv.ParseInt(match[1]+match[2], 10, 64)
+	}
+
+	output.Revision.Real = int64(len(realRegex.FindAllString(title, -1)))
+
+	return output
+}
+
+// parseGroup returns the release group at the end of a title, or at the start of an anime title.
+func parseGroup(title string) string {
+	if match := animeGroupRegex.FindStringSubmatch(title); match != nil {
+		return match[1]
+	}
+
+	if match := groupRegex.FindStringSubmatch(title); match != nil {
+		for _, not := range notGroups {
+			if strings.EqualFold(match[1], not) {
+				return ""
+			}
+		}
+
+		return match[1]
+	}
+
+	if match := bracketGroup.FindStringSubmatch(title); match != nil {
+		return match[1]
+	}
+
+	return ""
+}
+
+// parseTitle finds the series or movie title, and the year.
+func (r *Release) parseTitle(title string) {
+	if match := animeTitleRegex.FindStringSubmatch(title); match != nil {
+		r.Title = strings.TrimSpace(match[1])
+		return
+	}
+
+	// A title may start with a year, like 1917, so the title ends at the first marker after the start.
+	name := title
+
+	for _, loc := range titleSplitRegex.FindAllStringIndex(title, -1) {
+		if loc[0] > 0 {
+			name = title[:loc[0]]
+			break
+		}
+	}
+
+	if match := yearRegex.FindStringSubmatch(title[len(name):]); match != nil {
+		r.Year, _ = strconv.Atoi(match[1])
+	}
+
+	name = strings.NewReplacer(".", " ", "_", " ").Replace(name)
+	r.Title = strings.TrimSpace(strings.Trim(strings.TrimSpace(name), "-([ "))
+}
@@ -0,0 +1,106 @@
+package starrparse_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/starrparse"
+)
+
+// corpus is a release title, and what the app should return for it. TestParseRecorded checks
+// these against parse output recorded from the apps. Differs lists the fields (by JSON name)
+// where starrparse knowingly returns something else than the app, and why.
+type corpus struct {
+	App        starr.App `json:"app"`
+	Input      string    `json:"input"`
+	Quality    string    `json:"quality"`
+	QualityID  int64     `json:"qualityId"`
+	Version    int64     `json:"version"`
+	Repack     bool      `json:"repack"`
+	Title      string    `json:"title"`
+	Year       int       `json:"year"`
+	Season     int       `json:"season"`
+	Episodes   []int     `json:"episodes"`
+	Absolute   []int     `json:"absolute"`
+	FullSeason bool      `json:"fullSeason"`
+	Group      string    `json:"group"`
+	Codec      string    `json:"codec"`
+	Audio      string    `json:"audio"`
+	Channels   string    `json:"channels"`
+	Languages  []string  `json:"languages"`
+	// Differs is only checked against recorded output. Fields the apps do not return
+	// (codec, audio and channels) are never compared.
+	Differs map[string]string `json:"differs"`
+}
+
+// loadCorpus reads the release titles from testdata.
+func loadCorpus(t *testing.T) []*corpus {
+	t.Helper()
+
+	data, err := os.ReadFile("testdata/titles.json")
+	require.NoError(t, err)
+
+	var titles []*corpus
+	require.NoError(t, json.Unmarshal(data, &titles))
+
+	return titles
+}
+
+func TestParseCorpus(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range loadCorpus(t) {
+		t.Run(test.Input, func(t *testing.T) {
+			t.Parallel()
+
+			release := starrparse.Parse(test.App, test.Input)
+			require.NotNil(t, release.Quality)
+			assert.Equal(t, test.Quality, release.Quality.Quality.Name)
+			assert.Equal(t, test.QualityID, release.Quality.Quality.ID)
+			assert.Equal(t, max(test.Version, 1), release.Quality.Revision.Version)
+			assert.Equal(t, test.Repack, release.Quality.Revision.IsRepack)
+			assert.Equal(t, test.Title, release.Title)
+			assert.Equal(t, test.Year, release.Year)
+			assert.Equal(t, test.Season, release.SeasonNumber)
+			assert.Equal(t, test.Episodes, release.EpisodeNumbers)
+			assert.Equal(t, test.Absolute, release.AbsoluteEpisodeNumbers)
+			assert.Equal(t, test.FullSeason, release.FullSeason)
+			assert.Equal(t, test.Group, release.ReleaseGroup)
+			assert.Equal(t, test.Codec, release.Codec)
+			assert.Equal(t, test.Audio, release.Audio)
+			assert.Equal(t, test.Channels, release.AudioChannels)
+
+			languages := []string{}
+			for _, lang := range release.Languages {
+				languages = append(languages, lang.Name)
+			}
+
+			if test.Languages == nil {
+				test.Languages = []string{"English"}
+			}
+
+			assert.Equal(t, test.Languages, languages)
+		})
+	}
+}
+
+func TestParseApps(t *testing.T) {
+	t.Parallel()
+
+	title := "Movie.Title.2019.2160p.UHD.BluRay.REMUX.HDR.HEVC.TrueHD.Atmos.7.1-FGT"
+
+	assert.Equal(t, &starr.BaseQuality{ID: 21, Name: "Bluray-2160p Remux", Source: "blurayRaw", Resolution: 2160},
+		starrparse.Parse(starr.Sonarr, title).Quality.Quality)
+	assert.Equal(t, &starr.BaseQuality{
+		ID: 31, Name: "Remux-2160p", Source: "bluray", Resolution: 2160, Modifier: "remux",
+	}, starrparse.Parse(starr.Radarr, title).Quality.Quality)
+	assert.Nil(t, starrparse.Parse(starr.Lidarr, title).Quality)
+
+	// Sonarr has no cam qualities.
+	assert.Equal(t, &starr.BaseQuality{Name: "Unknown", Source: "unknown"},
+		starrparse.Parse(starr.Sonarr, "Movie.2022.HDCAM.x264-GRP").Quality.Quality)
+}
@@ -0,0 +1,236 @@
+package starrparse
+
+import (
+	"regexp"
+
+	"golift.io/starr"
+)
+
+/* This file finds a release's source, resolution and modifier, and turns them into
+ * the quality definitions Sonarr and Radarr use. The IDs, names, sources and resolutions
+ * match the Quality classes in each app's source code.
+ */
+
+// Sources found in release titles. These are not app values; see Release.Quality for those.
+const (
+	SourceUnknown   = ""
+	SourceCam       = "cam"
+	SourceTelesync  = "telesync"
+	SourceTelecine  = "telecine"
+	SourceWorkprint = "workprint"
+	SourceScreener  = "screener"
+	SourceRegional  = "regional"
+	SourceTV        = "tv"
+	SourceDVD       = "dvd"
+	SourceWebDL     = "webdl"
+	SourceWebRip    = "webrip"
+	SourceBluray    = "bluray"
+)
+
+// Modifiers found in release titles.
+const (
+	ModifierNone   = ""
+	ModifierRemux  = "remux"
+	ModifierBRDisk = "brdisk"
+	ModifierRawHD  = "rawhd"
+	ModifierDVDR   = "dvdr"
+)
+
+// The order matters: the first match wins, so specific sources come before general ones.
+//
+//nolint:gochecknoglobals // these are compiled once.
+var (
+	sources = []struct {
+		source string
+		regex  *regexp.Regexp
+	}{
+		{SourceWebRip, regexp.MustCompile(`(?i)\bweb[-. ]?rip|\bwebrip`)},
+		{SourceWebDL, regexp.MustCompile(`(?i)\bweb[-. ]?(?:dl|hd)\b|\bwebdl\b|\bweb\b` +
+			`|\b(?:amzn|nf|dsnp|hmax|atvp|itunes)\b`)},
+		{SourceBluray, regexp.MustCompile(`(?i)\b(?:blu-?ray|bd-?rip|br-?rip|bd-?remux|hd-?dvd|uhd-?bd|bd\d{2,3})\b|\bbd\b`)},
+		{SourceScreener, regexp.MustCompile(`(?i)\b(?:dvd-?scr|screener|scr)\b`)},
+		{SourceRegional, regexp.MustCompile(`(?i)\b(?:r5|regional)\b`)},
+		{SourceDVD, regexp.MustCompile(`(?i)\b(?:dvd(?:-?rip|-?r|\d)?|ntsc|pal|xvidvd)\b`)},
+		{SourceTV, regexp.MustCompile(`(?i)\b(?:hdtv|pdtv|sdtv|tvrip|dsr|dsrip|dthrip|dvbrip|raw-?hd|uhdtv)\b`)},
+		// TS, TC and WP are also release group names, so these short tokens are checked last.
+		{SourceCam, regexp.MustCompile(`(?i)\b(?:cam|camrip|hdcam)\b`)},
+		{SourceTelesync, regexp.MustCompile(`(?i)\b(?:ts|telesync|hdts|pdvd)\b`)},
+		{SourceTelecine, regexp.MustCompile(`(?i)\b(?:tc|telecine|hdtc)\b`)},
+		{SourceWorkprint, regexp.MustCompile(`(?i)\b(?:workprint|wp)\b`)},
+	}
+	resolutions = []struct {
+		resolution int
+		regex      *regexp.Regexp
+	}{
+		{2160, regexp.MustCompile(`(?i)\b(?:2160p|3840x2160|4k)\b`)},
+		{1080, regexp.MustCompile(`(?i)\b(?:1080[pi]|1920x1080)\b`)},
+		{720, regexp.MustCompile(`(?i)\b(?:720p|1280x720)\b`)},
+		{576, regexp.MustCompile(`(?i)\b576p\b`)},
+		{480, regexp.MustCompile(`(?i)\b(?:480p|640x480|848x480)\b`)},
+	}
+	remuxRegex  = regexp.MustCompile(`(?i)\b(?:remux|bd-?remux)\b`)
+	brDiskRegex = regexp.MustCompile(`(?i)\b(?:bd25|bd50|bd66|bd100|br-?disk)\b|\bcomplete[. ](?:uhd[. ])?blu-?ray\b`)
+	rawHDRegex  = regexp.MustCompile(`(?i)\braw-?hd\b|\b1080i\b.*\bmpeg-?2\b`)
+	dvdrRegex   = regexp.MustCompile(`(?i)\b(?:dvd-?r|dvd5|dvd9)\b`)
+	sdRegex     = regexp.MustCompile(`(?i)\b(?:xvid|divx|x264|h\.?264)\b`)
+)
+
+// appQuality is one of an app's quality definitions.
+type appQuality struct {
+	source     string // Source found in the title.
+	resolution int
+	modifier   string
+	quality    starr.BaseQuality
+}
+
+// sonarrQualities are Sonarr's quality definitions. Sonarr has no modifier; remuxes use the blurayRaw source.
+//
+//nolint:gochecknoglobals,mnd // this is a read-only table.
+var sonarrQualities = []*appQuality{
+	{SourceTV, 480, "", starr.BaseQuality{ID: 1, Name: "SDTV", Source: "television", Resolution: 480}},
+	{SourceDVD, 480, "", starr.BaseQuality{ID: 2, Name: "DVD", Source: "dvd", Resolution: 480}},
+	{SourceWebDL, 1080, "", starr.BaseQuality{ID: 3, Name: "WEBDL-1080p", Source: "web", Resolution: 1080}},
+	{SourceTV, 720, "", starr.BaseQuality{ID: 4, Name: "HDTV-720p", Source: "television", Resolution: 720}},
+	{SourceWebDL, 720, "", starr.BaseQuality{ID: 5, Name: "WEBDL-720p", Source: "web", Resolution: 720}},
+	{SourceBluray, 720, "", starr.BaseQuality{ID: 6, Name: "Bluray-720p", Source: "bluray", Resolution: 720}},
+	{SourceBluray, 1080, "", starr.BaseQuality{ID: 7, Name: "Bluray-1080p", Source: "bluray", Resolution: 1080}},
+	{SourceWebDL, 480, "", starr.BaseQuality{ID: 8, Name: "WEBDL-480p", Source: "web", Resolution: 480}},
+	{SourceTV, 1080, "", starr.BaseQuality{ID: 9, Name: "HDTV-1080p", Source: "television", Resolution: 1080}},
+	{SourceTV, 1080, ModifierRawHD, starr.BaseQuality{ID: 10, Name: "Raw-HD", Source: "televisionRaw", Resolution: 1080}},
+	{SourceWebRip, 480, "", starr.BaseQuality{ID: 12, Name: "WEBRip-480p", Source: "webRip", Resolution: 480}},
+	{SourceBluray, 480, "", starr.BaseQuality{ID: 13, Name: "Bluray-480p", Source: "bluray", Resolution: 480}},
+	{SourceWebRip, 720, "", starr.BaseQuality{ID: 14, Name: "WEBRip-720p", Source: "webRip", Resolution: 720}},
+	{SourceWebRip, 1080, "", starr.BaseQuality{ID: 15, Name: "WEBRip-1080p", Source: "webRip", Resolution: 1080}},
+	{SourceTV, 2160, "", starr.BaseQuality{ID: 16, Name: "HDTV-2160p", Source: "television", Resolution: 2160}},
+	{SourceWebRip, 2160, "", starr.BaseQuality{ID: 17, Name: "WEBRip-2160p", Source: "webRip", Resolution: 2160}},
+	{SourceWebDL, 2160, "", starr.BaseQuality{ID: 18, Name: "WEBDL-2160p", Source: "web", Resolution: 2160}},
+	{SourceBluray, 2160, "", starr.BaseQuality{ID: 19, Name: "Bluray-2160p", Source: "bluray", Resolution: 2160}},
+	{SourceBluray, 1080, ModifierRemux, starr.BaseQuality{
+		ID: 20, Name: "Bluray-1080p Remux", Source: "blurayRaw", Resolution: 1080,
+	}},
+	{SourceBluray, 2160, ModifierRemux, starr.BaseQuality{
+		ID: 21, Name: "Bluray-2160p Remux", Source: "blurayRaw", Resolution: 2160,
+	}},
+	{SourceBluray, 576, "", starr.BaseQuality{ID: 22, Name: "Bluray-576p", Source: "bluray", Resolution: 576}},
+}
+
+// radarrQualities are Radarr's quality definitions.
+//
+//nolint:gochecknoglobals,mnd // this is a read-only table.
+var radarrQualities = []*appQuality{
+	{SourceTV, 480, "", radarrQuality(1, "SDTV", "tv", 480, "none")},
+	{SourceDVD, 480, "", radarrQuality(2, "DVD", "dvd", 480, "none")},
+	{SourceWebDL, 1080, "", radarrQuality(3, "WEBDL-1080p", "webdl", 1080, "none")},
+	{SourceTV, 720, "", radarrQuality(4, "HDTV-720p", "tv", 720, "none")},
+	{SourceWebDL, 720, "", radarrQuality(5, "WEBDL-720p", "webdl", 720, "none")},
+	{SourceBluray, 720, "", radarrQuality(6, "Bluray-720p", "bluray", 720, "none")},
+	{SourceBluray, 1080, "", radarrQuality(7, "Bluray-1080p", "bluray", 1080, "none")},
+	{SourceWebDL, 480, "", radarrQuality(8, "WEBDL-480p", "webdl", 480, "none")},
+	{SourceTV, 1080, "", radarrQuality(9, "HDTV-1080p", "tv", 1080, "none")},
+	{SourceTV, 1080, ModifierRawHD, radarrQuality(10, "Raw-HD", "tv", 1080, "rawhd")},
+	{SourceWebRip, 480, "", radarrQuality(12, "WEBRip-480p", "webrip", 480, "none")},
+	{SourceWebRip, 720, "", radarrQuality(14, "WEBRip-720p", "webrip", 720, "none")},
+	{SourceWebRip, 1080, "", radarrQuality(15, "WEBRip-1080p", "webrip", 1080, "none")},
+	{SourceTV, 2160, "", radarrQuality(16, "HDTV-2160p", "tv", 2160, "none")},
+	{SourceWebRip, 2160, "", radarrQuality(17, "WEBRip-2160p", "webrip", 2160, "none")},
+	{SourceWebDL, 2160, "", radarrQuality(18, "WEBDL-2160p", "webdl", 2160, "none")},
+	{SourceBluray, 2160, "", radarrQuality(19, "Bluray-2160p", "bluray", 2160, "none")},
+	{SourceBluray, 480, "", radarrQuality(20, "Bluray-480p", "bluray", 480, "none")},
+	{SourceBluray, 576, "", radarrQuality(21, "Bluray-576p", "bluray", 576, "none")},
+	{SourceBluray, 1080, ModifierBRDisk, radarrQuality(22, "BR-DISK", "bluray", 1080, "brdisk")},
+	{SourceDVD, 480, ModifierDVDR, radarrQuality(23, "DVD-R", "dvd", 480, "remux")},
+	{SourceWorkprint, 0, "", radarrQuality(24, "WORKPRINT", "workprint", 0, "none")},
+	{SourceCam, 0, "", radarrQuality(25, "CAM", "cam", 0, "none")},
+	{SourceTelesync, 0, "", radarrQuality(26, "TELESYNC", "telesync", 0, "none")},
+	{SourceTelecine, 0, "", radarrQuality(27, "TELECINE", "telecine", 0, "none")},
+	{SourceScreener, 480, "", radarrQuality(28, "DVDSCR", "dvd", 480, "screener")},
+	{SourceRegional, 480, "", radarrQuality(29, "REGIONAL", "dvd", 480, "regional")},
+	{SourceBluray, 1080, ModifierRemux, radarrQuality(30, "Remux-1080p", "bluray", 1080, "remux")},
+	{SourceBluray, 2160, ModifierRemux, radarrQuality(31, "Remux-2160p", "bluray", 2160, "remux")},
+}
+
+// radarrQuality returns one of Radarr's qualities. They all have a modifier.
+func radarrQuality(id int64, name, source string, resolution int, modifier string) starr.BaseQuality {
+	return starr.BaseQuality{ID: id, Name: name, Source: source, Resolution: resolution, Modifier: modifier}
+}
+
+// parseSource returns the source, resolution and modifier found in a title.
+func parseSource(title string) (string, int, string) {
+	source := SourceUnknown
+
+	for _, s := range sources {
+		if s.regex.MatchString(title) {
+			source = s.source
+			break
+		}
+	}
+
+	resolution := 0
+
+	for _, r := range resolutions {
+		if r.regex.MatchString(title) {
+			resolution = r.resolution
+			break
+		}
+	}
+
+	modifier := ModifierNone
+
+	switch {
+	case rawHDRegex.MatchString(title):
+		source, modifier = SourceTV, ModifierRawHD
+	case brDiskRegex.MatchString(title):
+		source, modifier = SourceBluray, ModifierBRDisk
+	case remuxRegex.MatchString(title):
+		source, modifier = SourceBluray, ModifierRemux
+	case source == SourceDVD && dvdrRegex.MatchString(title):
+		modifier = ModifierDVDR
+	}
+
+	return source, resolution, modifier
+}
+
+// quality picks an app's quality definition, or returns nil. It fills in the gaps the way the apps do:
+// a source without a resolution gets the source's lowest HD (or SD) resolution,
+// and a resolution without a source is treated as HDTV.
+//
+//nolint:mnd // resolutions.
+func quality(table []*appQuality, title, source string, resolution int, modifier string) *starr.BaseQuality {
+	switch {
+	case source == SourceUnknown && resolution == 0 && sdRegex.MatchString(title):
+		source, resolution = SourceTV, 480
+	case source == SourceUnknown && resolution != 0:
+		source = SourceTV
+	case source == SourceBluray && resolution == 0 && modifier == ModifierNone:
+		resolution = 720
+	case (source == SourceBluray && modifier != ModifierNone) || (source == SourceTV && modifier == ModifierRawHD):
+		if resolution == 0 || resolution == 720 {
+			resolution = 1080
+		}
+	case source == SourceWebDL, source == SourceWebRip, source == SourceTV:
+		if resolution == 0 || resolution == 576 {
+			resolution = 480
+		}
+	case source == SourceDVD, source == SourceScreener, source == SourceRegional:
+		resolution = 480
+	case source == SourceCam, source == SourceTelesync, source == SourceTelecine, source == SourceWorkprint:
+		resolution = 0
+	}
+
+	for _, q := range table {
+		if q.source == source && q.resolution == resolution && q.modifier == modifier {
+			output := q.quality
+			return &output
+		}
+	}
+
+	// A modifier this app does not have (like Sonarr and BR-DISK) falls back to the plain quality.
+	for _, q := range table {
+		if q.source == source && q.resolution == resolution && q.modifier == ModifierNone {
+			output := q.quality
+			return &output
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package starrparse_test
+
+/* These tests compare starrparse with parse output recorded from real Sonarr and Radarr instances.
+ * Record the cassettes with `make parsecorpus`, which needs these environment variables:
+ * STARR_SONARR_URL, STARR_SONARR_KEY, STARR_RADARR_URL and STARR_RADARR_KEY.
+ * Re-record them when a title is added to testdata/titles.json.
+ */
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/debuglog"
+	"golift.io/starr/radarr"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrparse"
+	"golift.io/starr/starrtest"
+)
+
+// parsed is the part of the parse output that starrparse also finds. Keys are the corpus JSON names.
+type parsed map[string]any
+
+// cassetteFile returns the path to the recorded parse output for an app.
+func cassetteFile(app starr.App) string {
+	return filepath.Join("testdata", app.Lower()+"-parse.json")
+}
+
+// TestRecordParseCorpus records every corpus title from a real app. It only runs with make parsecorpus.
+func TestRecordParseCorpus(t *testing.T) {
+	t.Parallel()
+
+	if os.Getenv("STARR_PARSE_RECORD") == "" {
+		t.Skip("set STARR_PARSE_RECORD to record parse output from real apps")
+	}
+
+	titles := loadCorpus(t)
+
+	for _, app := range []starr.App{starr.Sonarr, starr.Radarr} {
+		t.Run(string(app), func(t *testing.T) {
+			env := "STARR_" + strings.ToUpper(app.String())
+
+			url, key := os.Getenv(env+"_URL"), os.Getenv(env+"_KEY")
+			if url == "" || key == "" {
+				t.Skipf("set %[1]s_URL and %[1]s_KEY to record %[2]s", env, app)
+			}
+
+			config := starr.New(key, url, 0)
+			recorder := debuglog.NewRecordingRoundTripper(debuglog.Config{}, config.Client.Transport)
+			config.Client.Transport = recorder
+
+			for _, test := range titles {
+				if test.App == app {
+					_, err := parseWith(app, config, test.Input)
+					require.NoError(t, err, test.Input)
+				}
+			}
+
+			require.NoError(t, recorder.Save(cassetteFile(app)))
+		})
+	}
+}
+
+// TestParseRecorded compares starrparse with the recorded output of each app.
+func TestParseRecorded(t *testing.T) {
+	t.Parallel()
+
+	titles := loadCorpus(t)
+
+	for _, app := range []starr.App{starr.Sonarr, starr.Radarr} {
+		t.Run(string(app), func(t *testing.T) {
+			t.Parallel()
+
+			replay, err := starrtest.NewReplayRoundTripper(cassetteFile(app))
+			if errors.Is(err, os.ErrNotExist) {
+				t.Skipf("no recorded %s parse output; record it with make parsecorpus", app)
+			}
+
+			require.NoError(t, err)
+
+			config := &starr.Config{URL: "http://replay.invalid/", APIKey: "replay", Client: replay.Client()}
+
+			for _, test := range titles {
+				if test.App != app {
+					continue
+				}
+
+				want, err := parseWith(app, config, test.Input)
+				require.NoError(t, err, "%s is not recorded; run make parsecorpus", test.Input)
+
+				have := release(app, starrparse.Parse(app, test.Input))
+				for field := range test.Differs {
+					delete(want, field)
+					delete(have, field)
+				}
+
+				assert.Equal(t, want, have, test.Input)
+			}
+
+			replay.AssertDone(t)
+		})
+	}
+}
+
+// parseWith sends a title to the app's parse endpoint.
+func parseWith(app starr.App, config *starr.Config, title string) (parsed, error) {
+	if app == starr.Sonarr {
+		output, err := sonarr.New(config).Parse(&sonarr.ParseInput{Title: title})
+		if err != nil || output.ParsedEpisodeInfo == nil {
+			return nil, err //nolint:wrapcheck // it's a test.
+		}
+
+		info := output.ParsedEpisodeInfo
+
+		return parsed{
+			"title":      info.SeriesTitle,
+			"group":      info.ReleaseGroup,
+			"season":     info.SeasonNumber,
+			"episodes":   list(info.EpisodeNumbers),
+			"absolute":   list(info.AbsoluteEpisodeNumbers),
+			"fullSeason": info.FullSeason,
+			"quality":    quality(info.Quality),
+			"languages":  languages(info.Languages),
+		}, nil
+	}
+
+	output, err := radarr.New(config).Parse(title)
+	if err != nil || output.ParsedMovieInfo == nil {
+		return nil, err //nolint:wrapcheck // it's a test.
+	}
+
+	var info struct {
+		PrimaryMovieTitle string         `json:"primaryMovieTitle"`
+		Year              int            `json:"year"`
+		ReleaseGroup      string         `json:"releaseGroup"`
+		Quality           *starr.Quality `json:"quality"`
+		Languages         []*starr.Value `json:"languages"`
+	}
+
+	data, _ := json.Marshal(output.ParsedMovieInfo)
+	if err := json.Unmarshal(data, &info); err != nil {
+		return nil, err //nolint:wrapcheck // it's a test.
+	}
+
+	return parsed{
+		"title":     info.PrimaryMovieTitle,
+		"year":      info.Year,
+		"group":     info.ReleaseGroup,
+		"quality":   quality(info.Quality),
+		"languages": languages(info.Languages),
+	}, nil
+}
+
+// release converts starrparse output to the fields in parseWith for the same app.
+func release(app starr.App, rel *starrparse.Release) parsed {
+	output := parsed{
+		"title":     rel.Title,
+		"group":     rel.ReleaseGroup,
+		"quality":   quality(rel.Quality),
+		"languages": languages(rel.Languages),
+	}
+
+	if app == starr.Radarr {
+		output["year"] = rel.Year
+		return output
+	}
+
+	output["season"] = rel.SeasonNumber
+	output["episodes"] = list(rel.EpisodeNumbers)
+	output["absolute"] = list(rel.AbsoluteEpisodeNumbers)
+	output["fullSeason"] = rel.FullSeason
+
+	return output
+}
+
+// quality returns the quality ID, name and revision, like "7 Bluray-1080p v1".
+func quality(input *starr.Quality) string {
+	if input == nil || input.Quality == nil {
+		return ""
+	}
+
+	output := starr.Str(input.Quality.ID) + " " + input.Quality.Name
+
+	if input.Revision != nil {
+		output += " v" + starr.Str(max(input.Revision.Version, 1))
+
+		if input.Revision.IsRepack {
+			output += " repack"
+		}
+	}
+
+	return output
+}
+
+// languages returns the language names.
+func languages(input []*starr.Value) []string {
+	output := []string{}
+	for _, lang := range input {
+		output = append(output, lang.Name)
+	}
+
+	return output
+}
+
+// list makes empty lists nil, because the apps return empty lists and starrparse does not.
+func list(input []int) []int {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return input
+}
@@ -0,0 +1,127 @@
+// Package starrpush turns Prowlarr search results into release pushes for Sonarr, Radarr, Lidarr and Readarr.
+// Pick the app with App, build the input with Release, then pass it to that app's PushRelease method.
+//
+// Example:
+//
+//	app, push, err := starrpush.FromSearch(result)
+//	if err != nil {
+//		return err
+//	}
+//
+//	if app == starr.Sonarr {
+//		decisions, err := sonarrClient.PushRelease(push)
+//		...
+//	}
+package starrpush
+
+import (
+	"errors"
+
+	"golift.io/starr"
+	"golift.io/starr/prowlarr"
+	"golift.io/starr/starrshared"
+)
+
+// ErrUnknownApp is returned when a search result's categories and IDs do not point to exactly one app.
+var ErrUnknownApp = errors.New("starrpush: cannot tell which app the release is for")
+
+// Newznab category ranges. Prowlarr maps every indexer's categories into these.
+const (
+	catMovies    = 2000
+	catAudio     = 3000
+	catAudiobook = 3030
+	catTV        = 5000
+	catBooks     = 7000
+	catRange     = 1000
+)
+
+// synced is appended to indexer names when Prowlarr syncs them to an app.
+const synced = " (Prowlarr)"
+
+// FromSearch returns the app a search result is for, and the release to push to it.
+func FromSearch(search *prowlarr.Search) (starr.App, *starrshared.ReleasePush, error) {
+	app, err := App(search)
+	if err != nil {
+		return "", nil, err
+	}
+
+	push := Release(search)
+	if app != starr.Radarr {
+		push.ImdbID = 0 // Only Radarr takes a numeric IMDb ID.
+	}
+
+	return app, push, nil
+}
+
+// App returns the app a search result is for. The categories decide first. If they match none or
+// more than one app, the IDs decide: a TVDB or TVMaze ID means Sonarr, a TMDB or IMDb ID means Radarr.
+func App(search *prowlarr.Search) (starr.App, error) {
+	apps := map[starr.App]bool{}
+	addCategories(apps, search.Categories)
+
+	if len(apps) == 1 {
+		for app := range apps {
+			return app, nil
+		}
+	}
+
+	switch tv, movie := search.TvdbID != 0 || search.TvMazeID != 0, search.TmdbID != 0 || search.ImdbID != 0; {
+	case tv && !movie && (len(apps) == 0 || apps[starr.Sonarr]):
+		return starr.Sonarr, nil
+	case movie && !tv && (len(apps) == 0 || apps[starr.Radarr]):
+		return starr.Radarr, nil
+	default:
+		return "", ErrUnknownApp
+	}
+}
+
+// addCategories adds the app for each category, and its sub categories, to apps.
+// Indexer specific categories (100000 and up) are skipped; Prowlarr includes their standard parent.
+func addCategories(apps map[starr.App]bool, categories []*prowlarr.Category) {
+	for _, category := range categories {
+		switch {
+		case category.ID == catAudiobook:
+			apps[starr.Readarr] = true
+		case category.ID/catRange*catRange == catMovies:
+			apps[starr.Radarr] = true
+		case category.ID/catRange*catRange == catAudio:
+			apps[starr.Lidarr] = true
+		case category.ID/catRange*catRange == catTV:
+			apps[starr.Sonarr] = true
+		case category.ID/catRange*catRange == catBooks:
+			apps[starr.Readarr] = true
+		}
+
+		addCategories(apps, category.SubCategories)
+	}
+}
+
+// Release converts a search result into the input for PushRelease in any app.
+// The indexer is named the way Prowlarr names the indexers it syncs, so the app can find its settings.
+// Prowlarr's indexer ID is not used; it does not match the indexer IDs in the app.
+// The IMDb ID is only for Radarr; clear it before pushing to Sonarr. FromSearch does that for you.
+func Release(search *prowlarr.Search) *starrshared.ReleasePush {
+	push := &starrshared.ReleasePush{
+		Title:       search.Title,
+		DownloadURL: search.DownloadURL,
+		MagnetURL:   search.MagnetURL,
+		Protocol:    search.Protocol,
+		PublishDate: search.PublishDate,
+		GUID:        search.GUID,
+		InfoURL:     search.InfoURL,
+		CommentURL:  search.CommentURL,
+		Size:        search.Size,
+		InfoHash:    search.InfoHash,
+		Seeders:     search.Seeders,
+		Leechers:    search.Leechers,
+		TvdbID:      search.TvdbID,
+		TmdbID:      search.TmdbID,
+		ImdbID:      search.ImdbID,
+	}
+
+	if search.Indexer != "" {
+		push.Indexer = search.Indexer + synced
+	}
+
+	return push
+}
@@ -0,0 +1,111 @@
+package starrpush_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/prowlarr"
+	"golift.io/starr/starrpush"
+	"golift.io/starr/starrshared"
+)
+
+func categories(ids ...int64) []*prowlarr.Category {
+	output := make([]*prowlarr.Category, len(ids))
+	for idx, id := range ids {
+		output[idx] = &prowlarr.Category{ID: id}
+	}
+
+	return output
+}
+
+func TestApp(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		search *prowlarr.Search
+		app    starr.App
+		err    error
+	}{
+		{name: "tv", search: &prowlarr.Search{Categories: categories(5040, 100040)}, app: starr.Sonarr},
+		{name: "movies", search: &prowlarr.Search{Categories: categories(2000, 2045)}, app: starr.Radarr},
+		{name: "music", search: &prowlarr.Search{Categories: categories(3040)}, app: starr.Lidarr},
+		{name: "audiobook", search: &prowlarr.Search{Categories: categories(3030)}, app: starr.Readarr},
+		{name: "ebook", search: &prowlarr.Search{Categories: categories(7020)}, app: starr.Readarr},
+		{
+			name: "sub category",
+			search: &prowlarr.Search{Categories: []*prowlarr.Category{
+				{ID: 100001, SubCategories: categories(5070)},
+			}},
+			app: starr.Sonarr,
+		},
+		{
+			name:   "ids break a tie",
+			search: &prowlarr.Search{Categories: categories(2000, 5000), TmdbID: 603},
+			app:    starr.Radarr,
+		},
+		{name: "ids without categories", search: &prowlarr.Search{TvMazeID: 82}, app: starr.Sonarr},
+		{
+			name:   "ids do not match the categories",
+			search: &prowlarr.Search{Categories: categories(3000, 7000), TvdbID: 1},
+			err:    starrpush.ErrUnknownApp,
+		},
+		{name: "nothing", search: &prowlarr.Search{Categories: categories(4000)}, err: starrpush.ErrUnknownApp},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			app, err := starrpush.App(test.search)
+			require.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.app, app)
+		})
+	}
+}
+
+func TestFromSearch(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	app, push, err := starrpush.FromSearch(&prowlarr.Search{
+		GUID:        "guid",
+		Title:       "Show.S01E01.1080p.WEB.h264-GROUP",
+		Indexer:     "Tracker",
+		IndexerID:   4,
+		DownloadURL: "http://prowlarr/4/download",
+		Protocol:    starr.ProtocolTorrent,
+		PublishDate: date,
+		Size:        1234,
+		Seeders:     10,
+		TvdbID:      5,
+		ImdbID:      7,
+		Categories:  categories(5040),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, starr.Sonarr, app)
+	assert.Equal(t, &starrshared.ReleasePush{
+		Title:       "Show.S01E01.1080p.WEB.h264-GROUP",
+		DownloadURL: "http://prowlarr/4/download",
+		Protocol:    starr.ProtocolTorrent,
+		PublishDate: date,
+		GUID:        "guid",
+		Size:        1234,
+		Indexer:     "Tracker (Prowlarr)",
+		Seeders:     10,
+		TvdbID:      5,
+	}, push)
+
+	app, push, err = starrpush.FromSearch(&prowlarr.Search{Title: "Movie", TmdbID: 603, ImdbID: 133093})
+	require.NoError(t, err)
+	assert.Equal(t, starr.Radarr, app)
+	assert.Equal(t, &starrshared.ReleasePush{Title: "Movie", TmdbID: 603, ImdbID: 133093}, push)
+	assert.Equal(t, int64(7), starrpush.Release(&prowlarr.Search{ImdbID: 7}).ImdbID)
+
+	_, push, err = starrpush.FromSearch(&prowlarr.Search{})
+	require.ErrorIs(t, err, starrpush.ErrUnknownApp)
+	assert.Nil(t, push)
+}
@@ -0,0 +1,133 @@
+package starrshared
+
+import "golift.io/starr"
+
+// Download client implementations with typed settings.
+const (
+	ImplementationQBittorrent  = "QBittorrent"
+	ImplementationTransmission = "Transmission"
+	ImplementationDeluge       = "Deluge"
+	ImplementationSabnzbd      = "Sabnzbd"
+	ImplementationNzbget       = "Nzbget"
+)
+
+// QBittorrentSettings are the settings for a qBittorrent download client.
+// The category and priority field names depend on the app; EncodeSettings handles that.
+type QBittorrentSettings struct {
+	Host             string `field:"host"`
+	Port             int    `field:"port"`
+	UseSSL           bool   `field:"useSsl"`
+	URLBase          string `field:"urlBase"`
+	Username         string `field:"username"`
+	Password         string `field:"password"`
+	Category         string `field:"{category}"`
+	ImportedCategory string `field:"{importedCategory}"`
+	RecentPriority   int    `field:"{recentPriority}"`
+	OlderPriority    int    `field:"{olderPriority}"`
+	InitialState     int    `field:"initialState"`
+	SequentialOrder  bool   `field:"sequentialOrder"`
+	FirstAndLast     bool   `field:"firstAndLast"`
+	ContentLayout    int    `field:"contentLayout"`
+}
+
+// TransmissionSettings are the settings for a Transmission download client.
+type TransmissionSettings struct {
+	Host           string `field:"host"`
+	Port           int    `field:"port"`
+	UseSSL         bool   `field:"useSsl"`
+	URLBase        string `field:"urlBase"`
+	Username       string `field:"username"`
+	Password       string `field:"password"`
+	Category       string `field:"{category}"`
+	Directory      string `field:"{directory}"`
+	RecentPriority int    `field:"{recentPriority}"`
+	OlderPriority  int    `field:"{olderPriority}"`
+	AddPaused      bool   `field:"addPaused"`
+}
+
+// DelugeSettings are the settings for a Deluge download client.
+type DelugeSettings struct {
+	Host             string `field:"host"`
+	Port             int    `field:"port"`
+	UseSSL           bool   `field:"useSsl"`
+	URLBase          string `field:"urlBase"`
+	Password         string `field:"password"`
+	Category         string `field:"{category}"`
+	ImportedCategory string `field:"{importedCategory}"`
+	RecentPriority   int    `field:"{recentPriority}"`
+	OlderPriority    int    `field:"{olderPriority}"`
+	AddPaused        bool   `field:"addPaused"`
+}
+
+// SabnzbdSettings are the settings for a SABnzbd download client.
+type SabnzbdSettings struct {
+	Host           string `field:"host"`
+	Port           int    `field:"port"`
+	UseSSL         bool   `field:"useSsl"`
+	URLBase        string `field:"urlBase"`
+	APIKey         string `field:"apiKey"`
+	Username       string `field:"username"`
+	Password       string `field:"password"`
+	Category       string `field:"{category}"`
+	RecentPriority int    `field:"{recentPriority}"`
+	OlderPriority  int    `field:"{olderPriority}"`
+}
+
+// NzbgetSettings are the settings for an NZBGet download client.
+type NzbgetSettings struct {
+	Host           string `field:"host"`
+	Port           int    `field:"port"`
+	UseSSL         bool   `field:"useSsl"`
+	URLBase        string `field:"urlBase"`
+	Username       string `field:"username"`
+	Password       string `field:"password"`
+	Category       string `field:"{category}"`
+	RecentPriority int    `field:"{recentPriority}"`
+	OlderPriority  int    `field:"{olderPriority}"`
+	AddPaused      bool   `field:"addPaused"`
+}
+
+// Implementation returns the download client implementation name.
+func (*QBittorrentSettings) Implementation() string { return ImplementationQBittorrent }
+
+// ConfigContract returns the download client config contract name.
+func (*QBittorrentSettings) ConfigContract() string { return "QBittorrentSettings" }
+
+// Protocol returns the download protocol for this client.
+func (*QBittorrentSettings) Protocol() starr.Protocol { return starr.ProtocolTorrent }
+
+// Implementation returns the download client implementation name.
+func (*TransmissionSettings) Implementation() string { return ImplementationTransmission }
+
+// ConfigContract returns the download client config contract name.
+func (*TransmissionSettings) ConfigContract() string { return "TransmissionSettings" }
+
+// Protocol returns the download protocol for this client.
+func (*TransmissionSettings) Protocol() starr.Protocol { return starr.ProtocolTorrent }
+
+// Implementation returns the download client implementation name.
+func (*DelugeSettings) Implementation() string { return ImplementationDeluge }
+
+// ConfigContract returns the download client config contract name.
+func (*DelugeSettings) ConfigContract() string { return "DelugeSettings" }
+
+// Protocol returns the download protocol for this client.
+func (*DelugeSettings) Protocol() starr.Protocol { return starr.ProtocolTorrent }
+
+// Implementation returns the download client implementation name.
+func (*SabnzbdSettings) Implementation() string { return ImplementationSabnzbd }
+
+// ConfigContract returns the download client config contract name.
+func (*SabnzbdSettings) ConfigContract() string { return "SabnzbdSettings" }
+
+// Protocol returns the download protocol for this client.
+func (*SabnzbdSettings) Protocol() starr.Protocol { return starr.ProtocolUsenet }
+
+// Implementation returns the download client implementation name.
+func (*NzbgetSettings) Implementation() string { return ImplementationNzbget }
+
+// ConfigContract returns the download client config contract name.
+func (*NzbgetSettings) ConfigContract() string { return "NzbgetSettings" }
+
+// Protocol returns the download protocol for this client.
+func (*NzbgetSettings) Protocol() starr.Protocol { return starr.ProtocolUsenet }
@@ -0,0 +1,57 @@
+package starrshared
+
+import "golift.io/starr"
+
+// Indexer implementations with typed settings.
+const (
+	ImplementationNewznab = "Newznab"
+	ImplementationTorznab = "Torznab"
+)
+
+// NewznabSettings are the settings for a Newznab indexer.
+// Prowlarr does not have the anime fields, so leave them empty for Prowlarr.
+type NewznabSettings struct {
+	BaseURL                   string `field:"baseUrl"`
+	APIPath                   string `field:"apiPath"`
+	APIKey                    string `field:"apiKey"`
+	Categories                []int  `field:"categories"`
+	AnimeCategories           []int  `field:"animeCategories"`
+	AnimeStandardFormatSearch bool   `field:"animeStandardFormatSearch"`
+	AdditionalParameters      string `field:"additionalParameters"`
+	MultiLanguages            []int  `field:"multiLanguages"`
+}
+
+// TorznabSettings are the settings for a Torznab indexer.
+// Seed times are in minutes. Season pack seed time is only in Sonarr.
+type TorznabSettings struct {
+	BaseURL                   string   `field:"baseUrl"`
+	APIPath                   string   `field:"apiPath"`
+	APIKey                    string   `field:"apiKey"`
+	Categories                []int    `field:"categories"`
+	AnimeCategories           []int    `field:"animeCategories"`
+	AnimeStandardFormatSearch bool     `field:"animeStandardFormatSearch"`
+	AdditionalParameters      string   `field:"additionalParameters"`
+	MultiLanguages            []int    `field:"multiLanguages"`
+	MinimumSeeders            int      `field:"minimumSeeders"`
+	SeedRatio                 *float64 `field:"seedCriteria.seedRatio"`
+	SeedTime                  *int     `field:"seedCriteria.seedTime"`
+	SeasonPackSeedTime        *int     `field:"seedCriteria.seasonPackSeedTime"`
+}
+
+// Implementation returns the indexer implementation name.
+func (*NewznabSettings) Implementation() string { return ImplementationNewznab }
+
+// ConfigContract returns the indexer config contract name.
+func (*NewznabSettings) ConfigContract() string { return "NewznabSettings" }
+
+// Protocol returns the download protocol for this indexer.
+func (*NewznabSettings) Protocol() starr.Protocol { return starr.ProtocolUsenet }
+
+// Implementation returns the indexer implementation name.
+func (*TorznabSettings) Implementation() string { return ImplementationTorznab }
+
+// ConfigContract returns the indexer config contract name.
+func (*TorznabSettings) ConfigContract() string { return "TorznabSettings" }
+
+// Protocol returns the download protocol for this indexer.
+func (*TorznabSettings) Protocol() starr.Protocol { return starr.ProtocolTorrent }
@@ -0,0 +1,180 @@
+package starrshared
+
+import (
+	"context"
+	"iter"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"golift.io/starr"
+)
+
+// Defaults for TailLogs.
+const (
+	DefaultTailInterval = 5 * time.Second
+	DefaultTailPageSize = 100
+)
+
+// LogLine is one record from the /log endpoint.
+type LogLine struct {
+	ID            int       `json:"id"`
+	Time          time.Time `json:"time"`
+	Exception     string    `json:"exception,omitempty"`
+	ExceptionType string    `json:"exceptionType,omitempty"`
+	Level         string    `json:"level,omitempty"`
+	Logger        string    `json:"logger,omitempty"`
+	Message       string    `json:"message,omitempty"`
+	Method        string    `json:"method,omitempty"`
+}
+
+// LogPage is a page of log lines from the /log endpoint.
+type LogPage struct {
+	Page          int        `json:"page"`
+	PageSize      int        `json:"pageSize"`
+	SortKey       string     `json:"sortKey"`
+	SortDirection string     `json:"sortDirection"`
+	TotalRecords  int        `json:"totalRecords"`
+	Records       []*LogLine `json:"records"`
+}
+
+// LogFile describes a log file on disk.
+type LogFile struct {
+	Filename  string    `json:"filename,omitempty"`
+	Contents  string    `json:"contents,omitempty"`
+	LastWrite time.Time `json:"lastWrite,omitzero"`
+}
+
+// LogPageFetcher returns a page of log lines. Every app's GetLogPageContext method is one.
+type LogPageFetcher func(ctx context.Context, params *starr.PageReq) (*LogPage, error)
+
+// TailOptions filter and pace the log lines from TailLogs. A nil TailOptions uses the defaults.
+type TailOptions struct {
+	// Interval is the time between polls. Defaults to DefaultTailInterval.
+	Interval time.Duration
+	// PageSize is the number of lines requested in each poll. Defaults to DefaultTailPageSize.
+	PageSize int
+	// Since returns lines logged after this time. Zero starts with the next new line, like tail -f.
+	Since time.Time
+	// Levels returns only lines with these levels, like "warn" and "error". Empty returns every level.
+	Levels []string
+	// Loggers returns only lines from these loggers, like "RssSyncService". Empty returns every logger.
+	Loggers []string
+}
+
+// logPosition is the newest log line seen so far.
+type logPosition struct {
+	time time.Time
+	id   int
+}
+
+// TailLogs returns an iterator that polls an app's log and yields only new lines, oldest first.
+// It runs until the context is cancelled, the consumer breaks out of the loop, or a poll fails.
+// The error is yielded with a nil line. This is used by the TailLogs methods in the app packages.
+func TailLogs(ctx context.Context, fetch LogPageFetcher, opts *TailOptions) iter.Seq2[*LogLine, error] {
+	opts = opts.defaults()
+
+	return func(yield func(*LogLine, error) bool) {
+		// Lines logged at the Since time are not new, no matter their ID.
+		last := &logPosition{time: opts.Since, id: math.MaxInt}
+
+		if last.time.IsZero() {
+			// Start after the newest line in the log.
+			page, err := fetch(ctx, tailRequest(1, 1))
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			if len(page.Records) > 0 {
+				last = &logPosition{time: page.Records[0].Time, id: page.Records[0].ID}
+			}
+		}
+
+		for {
+			lines, err := newLogLines(ctx, fetch, last, opts.PageSize)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			for _, line := range lines {
+				last = &logPosition{time: line.Time, id: line.ID}
+
+				if opts.match(line) && !yield(line, nil) {
+					return
+				}
+			}
+
+			timer := time.NewTimer(opts.Interval)
+
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+		}
+	}
+}
+
+// newLogLines returns the lines logged after the last position, oldest first.
+// Lines are requested newest first, and pages are requested until one has a line already seen.
+func newLogLines(ctx context.Context, fetch LogPageFetcher, last *logPosition, pageSize int) ([]*LogLine, error) {
+	lines := []*LogLine{}
+
+	for page := 1; ; page++ {
+		output, err := fetch(ctx, tailRequest(page, pageSize))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, line := range output.Records {
+			if !line.Time.After(last.time) && (!line.Time.Equal(last.time) || line.ID <= last.id) {
+				slices.Reverse(lines)
+				return lines, nil
+			}
+
+			lines = append(lines, line)
+		}
+
+		if len(output.Records) == 0 || page*pageSize >= output.TotalRecords {
+			slices.Reverse(lines)
+			return lines, nil
+		}
+	}
+}
+
+// tailRequest returns the parameters for a page of log lines, newest first.
+func tailRequest(page, pageSize int) *starr.PageReq {
+	return &starr.PageReq{Page: page, PageSize: pageSize, SortKey: "time", SortDir: starr.SortDescend}
+}
+
+func (o *TailOptions) defaults() *TailOptions {
+	output := &TailOptions{}
+	if o != nil {
+		*output = *o
+	}
+
+	if output.Interval <= 0 {
+		output.Interval = DefaultTailInterval
+	}
+
+	if output.PageSize <= 0 {
+		output.PageSize = DefaultTailPageSize
+	}
+
+	return output
+}
+
+// match returns true if a line has one of the levels and loggers in the options.
+func (o *TailOptions) match(line *LogLine) bool {
+	has := func(list []string, value string) bool {
+		return len(list) == 0 || slices.ContainsFunc(list, func(item string) bool {
+			return strings.EqualFold(item, value)
+		})
+	}
+
+	return has(o.Levels, line.Level) && has(o.Loggers, line.Logger)
+}
@@ -0,0 +1,110 @@
+package starrshared_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/starrshared"
+)
+
+// fakeLog serves pages of log lines, newest first, like the /log endpoint sorted by time.
+type fakeLog struct {
+	mu    sync.Mutex
+	lines []*starrshared.LogLine // oldest first.
+	next  func()                 // called once, on the second fetch.
+	calls int
+}
+
+func (f *fakeLog) add(level, logger, message string) {
+	f.lines = append(f.lines, &starrshared.LogLine{
+		ID:      len(f.lines) + 1,
+		Time:    time.Date(2025, 1, 1, 0, 0, len(f.lines), 0, time.UTC),
+		Level:   level,
+		Logger:  logger,
+		Message: message,
+	})
+}
+
+func (f *fakeLog) fetch(_ context.Context, params *starr.PageReq) (*starrshared.LogPage, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.calls++; f.calls == 2 && f.next != nil {
+		f.next()
+	}
+
+	page := &starrshared.LogPage{Page: params.Page, PageSize: params.PageSize, TotalRecords: len(f.lines)}
+
+	for idx := len(f.lines) - 1 - (params.Page-1)*params.PageSize; idx >= 0; idx-- {
+		if len(page.Records) == params.PageSize {
+			break
+		}
+
+		page.Records = append(page.Records, f.lines[idx])
+	}
+
+	return page, nil
+}
+
+func TestTailLogs(t *testing.T) {
+	t.Parallel()
+
+	log := &fakeLog{}
+	log.add("error", "Old", "before the tail")
+	log.next = func() {
+		log.add("error", "Indexer", "first")
+		log.add("info", "Indexer", "filtered")
+		log.add("warn", "Indexer", "second")
+		log.add("debug", "Indexer", "filtered")
+		log.add("Error", "Indexer", "third")
+		log.add("error", "Indexer", "never read")
+	}
+
+	opts := &starrshared.TailOptions{Interval: time.Millisecond, PageSize: 2, Levels: []string{"Warn", "error"}}
+	messages := []string{}
+
+	for line, err := range starrshared.TailLogs(t.Context(), log.fetch, opts) {
+		require.NoError(t, err)
+
+		messages = append(messages, line.Message)
+		if len(messages) == 3 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"first", "second", "third"}, messages)
+}
+
+func TestTailLogsSince(t *testing.T) {
+	t.Parallel()
+
+	log := &fakeLog{}
+	for _, message := range []string{"one", "two", "three", "four", "five"} {
+		log.add("info", "RssSyncService", message)
+	}
+
+	log.add("info", "Other", "six")
+
+	opts := &starrshared.TailOptions{
+		Interval: time.Millisecond,
+		PageSize: 2,
+		Since:    log.lines[1].Time,
+		Loggers:  []string{"rsssyncservice"},
+	}
+	messages := []string{}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+
+	for line, err := range starrshared.TailLogs(ctx, log.fetch, opts) {
+		require.NoError(t, err)
+		messages = append(messages, line.Message)
+	}
+
+	assert.Equal(t, []string{"three", "four", "five"}, messages, "lines are yielded once, oldest first")
+}
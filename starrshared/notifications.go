@@ -0,0 +1,69 @@
+package starrshared
+
+// Notification implementations with typed settings.
+const (
+	ImplementationWebhook      = "Webhook"
+	ImplementationDiscord      = "Discord"
+	ImplementationEmail        = "Email"
+	ImplementationCustomScript = "CustomScript"
+)
+
+// WebhookSettings are the settings for a Webhook notification. Method is 1 for POST and 2 for PUT.
+type WebhookSettings struct {
+	URL      string `field:"url"`
+	Method   int    `field:"method"`
+	Username string `field:"username"`
+	Password string `field:"password"`
+}
+
+// DiscordSettings are the settings for a Discord notification.
+type DiscordSettings struct {
+	WebHookURL string `field:"webHookUrl"`
+	Username   string `field:"username"`
+	Avatar     string `field:"avatar"`
+	Author     string `field:"author"`
+}
+
+// EmailSettings are the settings for an Email notification.
+// UseEncryption is 0 for preferred, 1 for always and 2 for never.
+type EmailSettings struct {
+	Server        string   `field:"server"`
+	Port          int      `field:"port"`
+	UseEncryption int      `field:"useEncryption"`
+	Username      string   `field:"username"`
+	Password      string   `field:"password"`
+	From          string   `field:"from"`
+	To            []string `field:"to"`
+	CC            []string `field:"cc"`
+	BCC           []string `field:"bcc"`
+}
+
+// CustomScriptSettings are the settings for a Custom Script notification.
+type CustomScriptSettings struct {
+	Path      string `field:"path"`
+	Arguments string `field:"arguments"`
+}
+
+// Implementation returns the notification implementation name.
+func (*WebhookSettings) Implementation() string { return ImplementationWebhook }
+
+// ConfigContract returns the notification config contract name.
+func (*WebhookSettings) ConfigContract() string { return "WebhookSettings" }
+
+// Implementation returns the notification implementation name.
+func (*DiscordSettings) Implementation() string { return ImplementationDiscord }
+
+// ConfigContract returns the notification config contract name.
+func (*DiscordSettings) ConfigContract() string { return "DiscordSettings" }
+
+// Implementation returns the notification implementation name.
+func (*EmailSettings) Implementation() string { return ImplementationEmail }
+
+// ConfigContract returns the notification config contract name.
+func (*EmailSettings) ConfigContract() string { return "EmailSettings" }
+
+// Implementation returns the notification implementation name.
+func (*CustomScriptSettings) Implementation() string { return ImplementationCustomScript }
+
+// ConfigContract returns the notification config contract name.
+func (*CustomScriptSettings) ConfigContract() string { return "CustomScriptSettings" }
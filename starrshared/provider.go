@@ -0,0 +1,42 @@
+package starrshared
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"golift.io/starr"
+)
+
+// ProviderTestResult is the result for one provider from a testall endpoint,
+// like /indexer/testall or /notification/testall.
+type ProviderTestResult struct {
+	ID                 int64                  `json:"id"`
+	IsValid            bool                   `json:"isValid"`
+	ValidationFailures starr.ValidationErrors `json:"validationFailures,omitempty"`
+}
+
+// TestResultsFromError fills output from the error a testall endpoint returned, and returns true if it did.
+// The apps reply with a 400 when any provider fails validation, but the body still has every result.
+// Any other error, including a 400 with a list of validation failures, returns false.
+func TestResultsFromError(err error, output *[]*ProviderTestResult) bool {
+	var reqErr *starr.ReqError
+	if !errors.As(err, &reqErr) || reqErr.Code != http.StatusBadRequest {
+		return false
+	}
+
+	var results []*ProviderTestResult
+	if json.Unmarshal(reqErr.Body, &results) != nil || len(results) == 0 {
+		return false
+	}
+
+	for _, result := range results {
+		if result == nil || result.ID == 0 {
+			return false // Validation failures look like this; they do not have provider IDs.
+		}
+	}
+
+	*output = results
+
+	return true
+}
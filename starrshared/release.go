@@ -0,0 +1,35 @@
+package starrshared
+
+import (
+	"time"
+
+	"golift.io/starr"
+)
+
+// ReleasePush is the input for the /release/push endpoint. Use it to hand a release found elsewhere,
+// like an RSS feed or a Prowlarr search, to an app. The app evaluates it like an RSS release, and
+// grabs it if it's wanted. Title, Protocol, PublishDate and one of DownloadURL or MagnetURL are required.
+// The media IDs are optional hints for apps that use them; the others ignore them.
+// ImdbID is only for Radarr. Sonarr's imdbId is a string, so leave ImdbID empty when pushing to Sonarr.
+type ReleasePush struct {
+	Title            string         `json:"title"`
+	DownloadURL      string         `json:"downloadUrl,omitempty"`
+	MagnetURL        string         `json:"magnetUrl,omitempty"`
+	Protocol         starr.Protocol `json:"protocol"`
+	PublishDate      time.Time      `json:"publishDate"`
+	GUID             string         `json:"guid,omitempty"`
+	InfoURL          string         `json:"infoUrl,omitempty"`
+	CommentURL       string         `json:"commentUrl,omitempty"`
+	Size             int64          `json:"size,omitempty"`
+	Indexer          string         `json:"indexer,omitempty"`
+	IndexerID        int64          `json:"indexerId,omitempty"`
+	InfoHash         string         `json:"infoHash,omitempty"`
+	Seeders          int            `json:"seeders,omitempty"`
+	Leechers         int            `json:"leechers,omitempty"`
+	DownloadClientID int64          `json:"downloadClientId,omitempty"`
+	DownloadClient   string         `json:"downloadClient,omitempty"`
+	TvdbID           int64          `json:"tvdbId,omitempty"`
+	TvRageID         int64          `json:"tvRageId,omitempty"`
+	TmdbID           int64          `json:"tmdbId,omitempty"`
+	ImdbID           int64          `json:"imdbId,omitempty"`
+}
@@ -0,0 +1,132 @@
+package starrshared
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"golift.io/starr"
+)
+
+/* This file converts typed provider settings to and from the name/value fields the apps use.
+ * Settings structs tag each member with its field name: `field:"host"`. A few fields are
+ * named after the app's media, like tvCategory in Sonarr and movieCategory in Radarr. Those are
+ * tagged with a placeholder in braces, like `field:"{category}"`, and renamed per app below.
+ */
+
+// ErrSettingsType is returned when a field value cannot be stored in its settings member.
+var ErrSettingsType = errors.New("provider field has the wrong type")
+
+// ProviderSettings are typed settings for one provider implementation,
+// like a qBittorrent download client or a Discord notification.
+type ProviderSettings interface {
+	// Implementation is the value for the implementation property in a provider input.
+	Implementation() string
+	// ConfigContract is the value for the configContract property in a provider input.
+	ConfigContract() string
+}
+
+// appFieldNames are the names each app uses for the placeholder fields.
+// An empty name means the app does not have that field.
+//
+//nolint:gochecknoglobals // this is a read-only table.
+var appFieldNames = map[starr.App]map[string]string{
+	starr.Sonarr: {
+		"{category}": "tvCategory", "{importedCategory}": "tvImportedCategory", "{directory}": "tvDirectory",
+		"{recentPriority}": "recentTvPriority", "{olderPriority}": "olderTvPriority",
+	},
+	starr.Radarr: {
+		"{category}": "movieCategory", "{importedCategory}": "movieImportedCategory", "{directory}": "movieDirectory",
+		"{recentPriority}": "recentMoviePriority", "{olderPriority}": "olderMoviePriority",
+	},
+	starr.Lidarr: {
+		"{category}": "musicCategory", "{importedCategory}": "musicImportedCategory", "{directory}": "musicDirectory",
+		"{recentPriority}": "recentTvPriority", "{olderPriority}": "olderTvPriority",
+	},
+	starr.Readarr: {
+		"{category}": "bookCategory", "{importedCategory}": "bookImportedCategory", "{directory}": "bookDirectory",
+		"{recentPriority}": "recentTvPriority", "{olderPriority}": "olderTvPriority",
+	},
+	starr.Prowlarr: {
+		"{category}": "category", "{importedCategory}": "", "{directory}": "directory",
+		"{recentPriority}": "priority", "{olderPriority}": "",
+	},
+}
+
+// fieldName returns the app's name for a settings member's field, or an empty string.
+func fieldName(app starr.App, member reflect.StructField) string {
+	name := member.Tag.Get("field")
+	if strings.HasPrefix(name, "{") {
+		return appFieldNames[app][name]
+	}
+
+	return name
+}
+
+// EncodeSettings converts typed settings into the fields for a provider input.
+// Nil slices and pointers are left out. Pass the app the input is for, so media fields get the right name.
+func EncodeSettings(app starr.App, settings ProviderSettings) []*starr.FieldInput {
+	value := reflect.Indirect(reflect.ValueOf(settings))
+	fields := []*starr.FieldInput{}
+
+	for idx := range value.NumField() {
+		name := fieldName(app, value.Type().Field(idx))
+		member := value.Field(idx)
+
+		if name == "" || ((member.Kind() == reflect.Slice || member.Kind() == reflect.Pointer) && member.IsNil()) {
+			continue
+		}
+
+		fields = append(fields, &starr.FieldInput{Name: name, Value: member.Interface()})
+	}
+
+	return fields
+}
+
+// DecodeSettings fills typed settings from the fields in a provider output. Settings must be a pointer.
+// Fields the settings do not have are ignored, and members without a field are left alone.
+func DecodeSettings(app starr.App, fields []*starr.FieldOutput, settings ProviderSettings) error {
+	values := make(map[string]any, len(fields))
+	for _, field := range fields {
+		values[field.Name] = field.Value
+	}
+
+	return decodeSettings(app, values, settings)
+}
+
+// DecodeSettingsInput fills typed settings from the fields in a provider input. Settings must be a pointer.
+func DecodeSettingsInput(app starr.App, fields []*starr.FieldInput, settings ProviderSettings) error {
+	values := make(map[string]any, len(fields))
+	for _, field := range fields {
+		values[field.Name] = field.Value
+	}
+
+	return decodeSettings(app, values, settings)
+}
+
+func decodeSettings(app starr.App, values map[string]any, settings ProviderSettings) error {
+	value := reflect.ValueOf(settings).Elem()
+
+	for idx := range value.NumField() {
+		name := fieldName(app, value.Type().Field(idx))
+
+		val, ok := values[name]
+		if name == "" || !ok || val == nil {
+			continue
+		}
+
+		// The values are decoded JSON (or anything the caller put in an input), so round trip them.
+		data, err := json.Marshal(val)
+		if err != nil {
+			return fmt.Errorf("%w: %s: %w", ErrSettingsType, name, err)
+		}
+
+		if err := json.Unmarshal(data, value.Field(idx).Addr().Interface()); err != nil {
+			return fmt.Errorf("%w: %s: %w", ErrSettingsType, name, err)
+		}
+	}
+
+	return nil
+}
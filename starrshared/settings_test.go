@@ -0,0 +1,79 @@
+package starrshared_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/starrshared"
+)
+
+func fieldNames(fields []*starr.FieldInput) []string {
+	names := make([]string, len(fields))
+	for idx, field := range fields {
+		names[idx] = field.Name
+	}
+
+	return names
+}
+
+func TestEncodeSettings(t *testing.T) {
+	t.Parallel()
+
+	settings := &starrshared.QBittorrentSettings{Host: "qbit", Port: 8080, Category: "media", ImportedCategory: "done"}
+
+	sonarr := fieldNames(starrshared.EncodeSettings(starr.Sonarr, settings))
+	assert.Contains(t, sonarr, "tvCategory")
+	assert.Contains(t, sonarr, "tvImportedCategory")
+	assert.Contains(t, sonarr, "recentTvPriority")
+
+	radarr := fieldNames(starrshared.EncodeSettings(starr.Radarr, settings))
+	assert.Contains(t, radarr, "movieCategory")
+	assert.Contains(t, radarr, "olderMoviePriority")
+
+	prowlarr := fieldNames(starrshared.EncodeSettings(starr.Prowlarr, settings))
+	assert.Contains(t, prowlarr, "category")
+	assert.Contains(t, prowlarr, "priority")
+	assert.NotContains(t, prowlarr, "")
+	assert.Len(t, prowlarr, len(sonarr)-2, "prowlarr has no imported category or older priority")
+
+	// Nil slices and pointers are not sent.
+	torznab := fieldNames(starrshared.EncodeSettings(starr.Radarr, &starrshared.TorznabSettings{BaseURL: "http://jackett"}))
+	assert.NotContains(t, torznab, "categories")
+	assert.NotContains(t, torznab, "seedCriteria.seedRatio")
+}
+
+func TestDecodeSettings(t *testing.T) {
+	t.Parallel()
+
+	ratio, seedTime := 1.5, 60
+	input := &starrshared.TorznabSettings{
+		BaseURL:    "http://prowlarr:9696/1/",
+		APIPath:    "/api",
+		APIKey:     "secret",
+		Categories: []int{5030, 5040},
+		SeedRatio:  &ratio,
+		SeedTime:   &seedTime,
+	}
+
+	// Send the fields through JSON, so values look like they came from the app.
+	data, err := json.Marshal(starrshared.EncodeSettings(starr.Sonarr, input))
+	require.NoError(t, err)
+
+	var fields []*starr.FieldOutput
+	require.NoError(t, json.Unmarshal(data, &fields))
+
+	output := &starrshared.TorznabSettings{}
+	require.NoError(t, starrshared.DecodeSettings(starr.Sonarr, fields, output))
+	assert.Equal(t, input, output)
+
+	fromInput := &starrshared.TorznabSettings{}
+	require.NoError(t, starrshared.DecodeSettingsInput(starr.Sonarr, starrshared.EncodeSettings(starr.Sonarr, input), fromInput))
+	assert.Equal(t, input, fromInput)
+
+	email := &starrshared.EmailSettings{}
+	err = starrshared.DecodeSettings(starr.Lidarr, []*starr.FieldOutput{{Name: "port", Value: "not a number"}}, email)
+	require.ErrorIs(t, err, starrshared.ErrSettingsType)
+}
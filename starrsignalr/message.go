@@ -0,0 +1,142 @@
+package starrsignalr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"golift.io/starr"
+	"golift.io/starr/lidarr"
+	"golift.io/starr/prowlarr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/readarr"
+	"golift.io/starr/sonarr"
+)
+
+// ErrNoResource is returned by Resource when a message has no resource, like a sync message.
+var ErrNoResource = errors.New("starrsignalr: message has no resource")
+
+// Message names sent by the apps. Not every app sends every message.
+const (
+	EventCommand      = "command"
+	EventQueue        = "queue"
+	EventQueueStatus  = "queue/status"
+	EventQueueDetails = "queue/details"
+	EventHealth       = "health"
+	EventCalendar     = "calendar"
+	EventSeries       = "series"
+	EventEpisode      = "episode"
+	EventMovie        = "movie"
+	EventArtist       = "artist"
+	EventAlbum        = "album"
+	EventAuthor       = "author"
+	EventBook         = "book"
+	EventIndexer      = "indexer"
+	// EventReconnect is not sent by the apps. The client sends it after it reconnects.
+	// Messages may have been missed while disconnected, so this is a good time to poll.
+	EventReconnect = "reconnect"
+)
+
+// Actions found in MessageBody.
+const (
+	ActionCreated = "created"
+	ActionUpdated = "updated"
+	ActionDeleted = "deleted"
+	ActionSync    = "sync"
+)
+
+// Message is a single real-time message from a Starr app.
+type Message struct {
+	// App is the application this message came from.
+	App starr.App `json:"-"`
+	// Name is the type of message. Compare it to the Event constants.
+	Name string      `json:"name"`
+	Body MessageBody `json:"body"`
+	// Data is Body.Resource decoded into a type from the app's package, if this library knows the type.
+	// For example, a Sonarr "command" message has a *sonarr.CommandResponse here.
+	// Data is nil when there is no resource, or the type is not known. Use Resource() in that case.
+	Data any `json:"-"`
+}
+
+// MessageBody is the body of a message. Sync messages only have an action.
+type MessageBody struct {
+	Action   string          `json:"action"`
+	Resource json.RawMessage `json:"resource,omitempty"`
+}
+
+// Resource decodes a message's resource into the type you provide.
+// Use this for messages that do not have a Data type, or to decode into your own type.
+func Resource[T any](msg *Message) (*T, error) {
+	if !msg.HasResource() {
+		return nil, fmt.Errorf("%w: %s", ErrNoResource, msg.Name)
+	}
+
+	var output T
+	if err := json.Unmarshal(msg.Body.Resource, &output); err != nil {
+		return nil, fmt.Errorf("decoding %s %s resource: %w", msg.App, msg.Name, err)
+	}
+
+	return &output, nil
+}
+
+// HasResource returns true if the message body contains a resource.
+func (m *Message) HasResource() bool {
+	return len(m.Body.Resource) > 0 && string(m.Body.Resource) != "null"
+}
+
+// resources returns a pointer to the type a resource is decoded into for Message.Data.
+//
+//nolint:gochecknoglobals // this is a read-only lookup table.
+var resources = map[starr.App]map[string]func() any{
+	starr.Sonarr: {
+		EventCommand:     func() any { return &sonarr.CommandResponse{} },
+		EventQueue:       func() any { return &sonarr.QueueRecord{} },
+		EventQueueStatus: func() any { return &sonarr.QueueStatus{} },
+		EventSeries:      func() any { return &sonarr.Series{} },
+		EventEpisode:     func() any { return &sonarr.Episode{} },
+	},
+	starr.Radarr: {
+		EventCommand: func() any { return &radarr.CommandResponse{} },
+		EventQueue:   func() any { return &radarr.QueueRecord{} },
+		EventMovie:   func() any { return &radarr.Movie{} },
+	},
+	starr.Lidarr: {
+		EventCommand: func() any { return &lidarr.CommandResponse{} },
+		EventQueue:   func() any { return &lidarr.QueueRecord{} },
+		EventArtist:  func() any { return &lidarr.Artist{} },
+		EventAlbum:   func() any { return &lidarr.Album{} },
+	},
+	starr.Readarr: {
+		EventCommand: func() any { return &readarr.CommandResponse{} },
+		EventQueue:   func() any { return &readarr.QueueRecord{} },
+		EventAuthor:  func() any { return &readarr.Author{} },
+		EventBook:    func() any { return &readarr.Book{} },
+	},
+	starr.Prowlarr: {
+		EventCommand: func() any { return &prowlarr.CommandResponse{} },
+		EventIndexer: func() any { return &prowlarr.IndexerOutput{} },
+	},
+}
+
+// decodeMessage turns a hub invocation argument into a Message, and decodes its Data.
+// The message is returned even if Data could not be decoded.
+func decodeMessage(app starr.App, data []byte) (*Message, error) {
+	msg := &Message{App: app}
+	if err := json.Unmarshal(data, msg); err != nil {
+		return nil, fmt.Errorf("decoding %s message: %w", app, err)
+	}
+
+	newData, ok := resources[app][msg.Name]
+	if !ok || !msg.HasResource() {
+		return msg, nil
+	}
+
+	output := newData()
+	if err := json.Unmarshal(msg.Body.Resource, output); err != nil {
+		return msg, fmt.Errorf("decoding %s %s resource: %w", app, msg.Name, err)
+	}
+
+	msg.Data = output
+
+	return msg, nil
+}
@@ -0,0 +1,393 @@
+// Package starrsignalr connects to the SignalR hub in Sonarr, Radarr, Lidarr, Readarr and Prowlarr,
+// and delivers the real-time messages it pushes on a channel. The apps use these messages to update
+// their web UI: command status, queue changes, health checks, and library changes.
+// Subscribing to them avoids polling endpoints like GetQueue every few seconds.
+//
+// Only the WebSockets transport and the JSON hub protocol are supported. The apps support both.
+package starrsignalr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/net/websocket"
+	"golift.io/starr"
+)
+
+// Defaults for a new Client.
+const (
+	DefaultBackoff      = time.Second
+	DefaultMaxBackoff   = time.Minute
+	DefaultPingInterval = 15 * time.Second
+	DefaultTimeout      = 30 * time.Second
+	DefaultBuffer       = 100
+)
+
+const (
+	hubPath         = "/signalr/messages"
+	recordSeparator = 0x1e
+	handshake       = `{"protocol":"json","version":1}`
+	ping            = `{"type":6}`
+)
+
+// SignalR hub protocol message types we care about.
+const (
+	typeInvocation = 1
+	typeClose      = 7
+)
+
+// Errors returned by this package.
+var (
+	// ErrNoWebSockets is returned when the app does not offer the WebSockets transport.
+	ErrNoWebSockets = errors.New("starrsignalr: websockets transport not available")
+	// ErrHandshake is returned when the hub rejects the protocol handshake.
+	ErrHandshake = errors.New("starrsignalr: handshake failed")
+	// ErrClosed is returned when the hub closes the connection.
+	ErrClosed = errors.New("starrsignalr: connection closed by server")
+)
+
+// Client connects to a Starr app's SignalR hub. Create one with New, and adjust the exported
+// fields before calling Subscribe. Each Subscribe call opens its own connection.
+type Client struct {
+	// Backoff is the delay before the first reconnect attempt. It doubles after each failure.
+	Backoff time.Duration
+	// MaxBackoff is the longest delay between reconnect attempts.
+	MaxBackoff time.Duration
+	// PingInterval is how often the client sends a keep-alive ping to the app.
+	PingInterval time.Duration
+	// Timeout is how long to wait for anything from the app before reconnecting.
+	// The apps send a ping every 15 seconds.
+	Timeout time.Duration
+	// Buffer is the size of the message channel.
+	Buffer int
+	// Errorf receives connection and decoding errors. The client reconnects on its own,
+	// so these are informational. They are discarded when this is nil, which is the default.
+	// Set it to log.Printf (or your own logger) to see them.
+	Errorf func(string, ...any)
+
+	config *starr.Config
+	app    starr.App
+}
+
+// negotiation is the response from the negotiate endpoint.
+type negotiation struct {
+	ConnectionID        string `json:"connectionId"`
+	ConnectionToken     string `json:"connectionToken"`
+	AvailableTransports []struct {
+		Transport string `json:"transport"`
+	} `json:"availableTransports"`
+}
+
+// frame is a single SignalR hub protocol record.
+type frame struct {
+	Type      int               `json:"type"`
+	Target    string            `json:"target"`
+	Arguments []json.RawMessage `json:"arguments"`
+	Error     string            `json:"error"`
+}
+
+// New returns a SignalR client for an app. The config's URL, API key and http client are used.
+func New(config *starr.Config, app starr.App) *Client {
+	return &Client{
+		Backoff:      DefaultBackoff,
+		MaxBackoff:   DefaultMaxBackoff,
+		PingInterval: DefaultPingInterval,
+		Timeout:      DefaultTimeout,
+		Buffer:       DefaultBuffer,
+		config:       config,
+		app:          app,
+	}
+}
+
+// Subscribe connects to the hub and returns a channel of messages. The first connection is made before
+// Subscribe returns, so a bad URL or API key returns an error. After that, the client reconnects with
+// backoff until the context is cancelled, and sends an EventReconnect message each time it does.
+// The channel is closed when the context is cancelled. You must keep reading from the channel.
+func (c *Client) Subscribe(ctx context.Context) (<-chan *Message, error) {
+	conn, err := c.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := make(chan *Message, c.Buffer)
+	go c.run(ctx, conn, msgs)
+
+	return msgs, nil
+}
+
+func (c *Client) run(ctx context.Context, conn *websocket.Conn, msgs chan *Message) {
+	defer close(msgs)
+
+	for {
+		err := c.read(ctx, conn, msgs)
+		_ = conn.Close()
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		c.errorf("%s SignalR connection lost, reconnecting: %v", c.app, err)
+
+		if conn = c.reconnect(ctx); conn == nil {
+			return
+		}
+
+		if !send(ctx, msgs, &Message{App: c.app, Name: EventReconnect}) {
+			_ = conn.Close()
+			return
+		}
+	}
+}
+
+// reconnect connects again with backoff. Returns nil if the context is cancelled first.
+func (c *Client) reconnect(ctx context.Context) *websocket.Conn {
+	delay := c.Backoff
+
+	for {
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+
+		conn, err := c.connect(ctx)
+		if err == nil {
+			return conn
+		} else if ctx.Err() != nil {
+			return nil
+		}
+
+		c.errorf("%s SignalR reconnect failed: %v", c.app, err)
+
+		if delay *= 2; delay > c.MaxBackoff {
+			delay = c.MaxBackoff
+		}
+	}
+}
+
+// connect negotiates a connection token, opens the websocket, and completes the protocol handshake.
+func (c *Client) connect(ctx context.Context) (*websocket.Conn, error) {
+	token, err := c.negotiate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	wsConfig, err := c.wsConfig(token)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := wsConfig.DialContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to %s: %w", hubPath, err)
+	}
+
+	if err := websocket.Message.Send(conn, handshake+string(rune(recordSeparator))); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("sending handshake: %w", err)
+	}
+
+	_ = conn.SetReadDeadline(time.Now().Add(c.Timeout))
+
+	var reply []byte
+	if err := websocket.Message.Receive(conn, &reply); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("reading handshake: %w", err)
+	}
+
+	record, _, _ := bytes.Cut(reply, []byte{recordSeparator})
+
+	var response frame
+	if err := json.Unmarshal(record, &response); err != nil || response.Error != "" {
+		_ = conn.Close()
+		return nil, fmt.Errorf("%w: %s", ErrHandshake, response.Error)
+	}
+
+	return conn, nil
+}
+
+func (c *Client) negotiate(ctx context.Context) (string, error) {
+	req := starr.Request{URI: hubPath + "/negotiate", Query: url.Values{"negotiateVersion": []string{"1"}}}
+
+	resp, err := c.config.Req(ctx, http.MethodPost, req)
+	if err != nil {
+		return "", fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+	defer resp.Body.Close()
+
+	var output negotiation
+	if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+		return "", fmt.Errorf("decoding negotiate response: %w", err)
+	}
+
+	if output.ConnectionToken == "" {
+		output.ConnectionToken = output.ConnectionID // negotiate version 0.
+	}
+
+	if len(output.AvailableTransports) == 0 {
+		return output.ConnectionToken, nil
+	}
+
+	for _, transport := range output.AvailableTransports {
+		if transport.Transport == "WebSockets" {
+			return output.ConnectionToken, nil
+		}
+	}
+
+	return "", ErrNoWebSockets
+}
+
+// wsConfig builds the websocket config with the same headers (and TLS settings) as the http client.
+func (c *Client) wsConfig(token string) (*websocket.Config, error) {
+	hub, err := url.Parse(strings.TrimSuffix(c.config.URL, "/") + hubPath)
+	if err != nil {
+		return nil, fmt.Errorf("parsing url: %w", err)
+	}
+
+	origin := hub.Scheme + "://" + hub.Host
+
+	switch hub.Scheme {
+	case "https":
+		hub.Scheme = "wss"
+	default:
+		hub.Scheme = "ws"
+	}
+
+	hub.RawQuery = url.Values{"id": []string{token}, "access_token": []string{c.config.APIKey}}.Encode()
+
+	wsConfig, err := websocket.NewConfig(hub.String(), origin)
+	if err != nil {
+		return nil, fmt.Errorf("websocket config: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, origin, nil) //nolint:noctx // only used for headers.
+	if err != nil {
+		return nil, fmt.Errorf("building headers: %w", err)
+	}
+
+	c.config.SetHeaders(req, nil)
+	wsConfig.Header = req.Header
+
+	if c.config.Client != nil {
+		if transport, ok := c.config.Client.Transport.(*http.Transport); ok && transport.TLSClientConfig != nil {
+			wsConfig.TlsConfig = transport.TLSClientConfig.Clone()
+		}
+	}
+
+	return wsConfig, nil
+}
+
+// read receives messages until the connection fails or the context is cancelled.
+func (c *Client) read(ctx context.Context, conn *websocket.Conn, msgs chan *Message) error {
+	done := make(chan struct{})
+	defer close(done)
+
+	go c.keepAlive(ctx, conn, done)
+
+	var buf []byte
+
+	for {
+		_ = conn.SetReadDeadline(time.Now().Add(c.Timeout))
+
+		var data []byte
+		if err := websocket.Message.Receive(conn, &data); err != nil {
+			return fmt.Errorf("reading: %w", err)
+		}
+
+		buf = append(buf, data...)
+
+		for {
+			idx := bytes.IndexByte(buf, recordSeparator)
+			if idx < 0 {
+				break
+			}
+
+			record := buf[:idx]
+			buf = buf[idx+1:]
+
+			if err := c.handle(ctx, record, msgs); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// keepAlive sends pings until done is closed. It closes the connection if the context is cancelled.
+func (c *Client) keepAlive(ctx context.Context, conn *websocket.Conn, done chan struct{}) {
+	ticker := time.NewTicker(c.PingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ctx.Done():
+			_ = conn.Close()
+			return
+		case <-ticker.C:
+			if err := websocket.Message.Send(conn, ping+string(rune(recordSeparator))); err != nil {
+				_ = conn.Close()
+				return
+			}
+		}
+	}
+}
+
+// handle decodes one hub protocol record and sends any messages in it to the channel.
+func (c *Client) handle(ctx context.Context, record []byte, msgs chan *Message) error {
+	var input frame
+	if err := json.Unmarshal(record, &input); err != nil {
+		c.errorf("%s SignalR: decoding record: %v", c.app, err)
+		return nil
+	}
+
+	switch input.Type {
+	case typeClose:
+		return fmt.Errorf("%w: %s", ErrClosed, input.Error)
+	case typeInvocation:
+		if !strings.EqualFold(input.Target, "receiveMessage") {
+			return nil
+		}
+	default: // pings and anything else we don't use.
+		return nil
+	}
+
+	for _, arg := range input.Arguments {
+		msg, err := decodeMessage(c.app, arg)
+		if err != nil {
+			c.errorf("%s SignalR: %v", c.app, err)
+		}
+
+		if msg != nil && !send(ctx, msgs, msg) {
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) errorf(msg string, format ...any) {
+	if c.Errorf != nil {
+		c.Errorf(msg, format...)
+	}
+}
+
+// send delivers a message, and returns false if the context is cancelled first.
+func send(ctx context.Context, msgs chan *Message, msg *Message) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case msgs <- msg:
+		return true
+	}
+}
@@ -0,0 +1,137 @@
+package starrsignalr_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+	"golift.io/starr"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrsignalr"
+)
+
+const (
+	apiKey    = "signalrAPIkey"
+	separator = "\x1e"
+)
+
+// hub is a fake SignalR hub. Each connection gets the next list of frames, and is then closed.
+// The last connection stays open until the test ends.
+func hub(t *testing.T, connections ...[]string) *httptest.Server {
+	t.Helper()
+
+	var count atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /signalr/messages/negotiate", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Key") != apiKey {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"connectionId":"abc","connectionToken":"token","negotiateVersion":1,` +
+			`"availableTransports":[{"transport":"WebSockets","transferFormats":["Text","Binary"]}]}`))
+	})
+	mux.Handle("/signalr/messages", websocket.Handler(func(conn *websocket.Conn) {
+		query := conn.Request().URL.Query()
+		if query.Get("access_token") != apiKey || query.Get("id") != "token" {
+			return
+		}
+
+		var handshake string
+		if websocket.Message.Receive(conn, &handshake) != nil || handshake != `{"protocol":"json","version":1}`+separator {
+			return
+		}
+
+		_ = websocket.Message.Send(conn, "{}"+separator)
+		idx := int(count.Add(1)) - 1
+
+		for _, frame := range connections[min(idx, len(connections)-1)] {
+			_ = websocket.Message.Send(conn, frame)
+		}
+
+		if idx < len(connections)-1 {
+			return
+		}
+
+		var discard string
+		for websocket.Message.Receive(conn, &discard) == nil {
+		}
+	}))
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestSubscribe(t *testing.T) {
+	t.Parallel()
+
+	server := hub(t, []string{
+		`{"type":6}` + separator +
+			`{"type":1,"target":"receiveMessage","arguments":[{"name":"command","body":{"action":"updated",` +
+			`"resource":{"id":5,"name":"RssSync","status":"started"}}}]}` + separator,
+	}, []string{
+		`{"type":1,"target":"receiveMessage","arguments":[{"name":"queue","body":{"action":"sync"}}]}` + separator +
+			`{"type":1,"target":"receiveMessage","arguments":[{"name":"queue",`,
+		`"body":{"action":"updated","resource":{"id":9,"seriesId":3,"title":"Some Show"}}}]}` + separator,
+	})
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	defer cancel()
+
+	client := starrsignalr.New(starr.New(apiKey, server.URL, 0), starr.Sonarr)
+	assert.Nil(t, client.Errorf, "a library must not log unless asked to")
+	client.Backoff = 10 * time.Millisecond
+	client.Errorf = t.Logf
+
+	msgs, err := client.Subscribe(ctx)
+	require.NoError(t, err)
+
+	msg := <-msgs
+	assert.Equal(t, starrsignalr.EventCommand, msg.Name)
+	assert.Equal(t, starrsignalr.ActionUpdated, msg.Body.Action)
+	require.IsType(t, &sonarr.CommandResponse{}, msg.Data)
+	assert.Equal(t, "started", msg.Data.(*sonarr.CommandResponse).Status)
+
+	msg = <-msgs
+	assert.Equal(t, starrsignalr.EventReconnect, msg.Name, "the first connection is closed by the server")
+
+	msg = <-msgs
+	assert.Equal(t, starrsignalr.ActionSync, msg.Body.Action)
+	assert.Nil(t, msg.Data)
+	assert.False(t, msg.HasResource())
+
+	// This record was split across two frames.
+	msg = <-msgs
+	require.IsType(t, &sonarr.QueueRecord{}, msg.Data)
+	assert.Equal(t, int64(3), msg.Data.(*sonarr.QueueRecord).SeriesID)
+
+	custom, err := starrsignalr.Resource[struct {
+		Title string `json:"title"`
+	}](msg)
+	require.NoError(t, err)
+	assert.Equal(t, "Some Show", custom.Title)
+
+	cancel()
+
+	for range msgs { //nolint:revive // drain until closed.
+	}
+}
+
+func TestSubscribeBadKey(t *testing.T) {
+	t.Parallel()
+
+	server := hub(t, []string{})
+	client := starrsignalr.New(starr.New("wrong", server.URL, 0), starr.Sonarr)
+
+	_, err := client.Subscribe(t.Context())
+	require.ErrorIs(t, err, &starr.ReqError{Code: http.StatusUnauthorized})
+}
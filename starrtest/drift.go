@@ -0,0 +1,642 @@
+package starrtest
+
+/* This file contains a test-time tool that compares the Go source in a starr
+ * app package against the bundled OpenAPI spec for that app. It only reads
+ * source files and the spec; it never talks to a running app.
+ */
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// DriftKind describes the type of difference found between a Go type and the OpenAPI spec.
+type DriftKind string
+
+// These are the kinds of drift SpecDrift reports.
+const (
+	// DriftMissingField means the spec schema has a property the Go struct does not model.
+	DriftMissingField DriftKind = "missing field"
+	// DriftUnknownField means the Go struct has a JSON field the spec schema does not define.
+	DriftUnknownField DriftKind = "unknown field"
+	// DriftWrongType means a Go field type does not match the spec property type.
+	DriftWrongType DriftKind = "wrong type"
+	// DriftUncoveredPath means a spec path is not called by any function in the package.
+	DriftUncoveredPath DriftKind = "uncovered path"
+)
+
+// Drift is a single difference between a Go package and its OpenAPI spec.
+type Drift struct {
+	Kind   DriftKind
+	GoType string // Empty for uncovered paths.
+	Schema string // Spec schema name, or the spec path for uncovered paths.
+	Field  string // JSON field name. Empty for uncovered paths.
+	Detail string // Extra information, like the mismatched types.
+}
+
+// String turns a drift into a one-line report.
+func (d *Drift) String() string {
+	if d.Kind == DriftUncoveredPath {
+		return fmt.Sprintf("%s: %s %s", d.Kind, d.Schema, d.Detail)
+	}
+
+	msg := fmt.Sprintf("%s: %s (%s) %s", d.Kind, d.GoType, d.Schema, d.Field)
+	if d.Detail != "" {
+		msg += ": " + d.Detail
+	}
+
+	return msg
+}
+
+// Spec is the small part of an OpenAPI 3 document that SpecDrift uses.
+type Spec struct {
+	Paths      map[string]map[string]json.RawMessage `json:"paths"`
+	Components struct {
+		Schemas map[string]*Schema `json:"schemas"`
+	} `json:"components"`
+}
+
+// Schema is an OpenAPI schema object.
+type Schema struct {
+	Ref        string             `json:"$ref"`
+	Type       string             `json:"type"`
+	Format     string             `json:"format"`
+	Enum       []any              `json:"enum"`
+	Items      *Schema            `json:"items"`
+	Properties map[string]*Schema `json:"properties"`
+	AllOf      []*Schema          `json:"allOf"`
+}
+
+// LoadSpec reads and parses an OpenAPI JSON file.
+func LoadSpec(specFile string) (*Spec, error) {
+	data, err := os.ReadFile(specFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading spec: %w", err)
+	}
+
+	var spec Spec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("parsing spec %s: %w", specFile, err)
+	}
+
+	return &spec, nil
+}
+
+// FindSpec returns the path to the bundled spec file for an app, like "radarr".
+// The specs live in the specs/ folder at the repo root; dir is any folder inside the repo.
+func FindSpec(dir, app string) (string, error) {
+	for dir, _ = filepath.Abs(dir); ; dir = filepath.Dir(dir) {
+		if matches, _ := filepath.Glob(filepath.Join(dir, "specs", strings.ToLower(app)+".*.json")); len(matches) > 0 {
+			slices.Sort(matches)
+			return matches[len(matches)-1], nil // newest.
+		}
+
+		if dir == filepath.Dir(dir) {
+			return "", fmt.Errorf("%w: no spec found for %s", os.ErrNotExist, app)
+		}
+	}
+}
+
+// SpecDrift compares the Go source files in pkgDir against an OpenAPI spec file.
+// Every exported struct type is matched to a spec schema by name (Movie matches MovieResource,
+// QueueRecord matches QueueResource, IndexerOutput matches IndexerResource, etc).
+// Extra maps Go type names to schema names for types the naming rules miss.
+// Structs without a matching schema are skipped. The output is sorted and stable.
+func SpecDrift(pkgDir, specFile string, extra map[string]string) ([]*Drift, error) {
+	spec, err := LoadSpec(specFile)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, err := parsePackage(pkgDir)
+	if err != nil {
+		return nil, err
+	}
+
+	drift := spec.compareStructs(pkg, extra)
+	drift = append(drift, spec.uncoveredPaths(pkg)...)
+
+	return drift, nil
+}
+
+// goPackage is the parsed source of one Go package.
+type goPackage struct {
+	types  map[string]ast.Expr // type name -> type expression.
+	consts map[string]ast.Expr // const name -> value expression.
+	funcs  []*ast.FuncDecl
+}
+
+func parsePackage(pkgDir string) (*goPackage, error) {
+	fset := token.NewFileSet()
+	pkg := &goPackage{types: make(map[string]ast.Expr), consts: make(map[string]ast.Expr)}
+
+	files, err := filepath.Glob(filepath.Join(pkgDir, "*.go"))
+	if err != nil {
+		return nil, fmt.Errorf("listing package files: %w", err)
+	}
+
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+
+		parsed, err := parser.ParseFile(fset, file, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", file, err)
+		}
+
+		pkg.addFile(parsed)
+	}
+
+	return pkg, nil
+}
+
+func (p *goPackage) addFile(file *ast.File) {
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			p.funcs = append(p.funcs, decl)
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					p.types[spec.Name.Name] = spec.Type
+				case *ast.ValueSpec:
+					if decl.Tok != token.CONST {
+						continue
+					}
+
+					for idx, name := range spec.Names {
+						if idx < len(spec.Values) {
+							p.consts[name.Name] = spec.Values[idx]
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// schemaFor finds the spec schema name for a Go type name.
+// Paged types (with a records list) match a paging schema, like HistoryResourcePagingResource.
+func (s *Spec) schemaFor(goName string, paged bool, extra map[string]string) string {
+	if name, ok := extra[goName]; ok {
+		return name
+	}
+
+	base := goName
+	for _, suffix := range []string{"Output", "Input", "Record"} {
+		base = strings.TrimSuffix(base, suffix)
+	}
+
+	candidates := []string{goName + "Resource", base + "Resource", goName}
+	if paged {
+		base = strings.TrimSuffix(strings.TrimSuffix(base, "Page"), "s")
+		candidates = []string{goName + "ResourcePagingResource", base + "ResourcePagingResource"}
+	}
+
+	for _, candidate := range candidates {
+		for name := range s.Components.Schemas {
+			if strings.EqualFold(name, candidate) {
+				return name
+			}
+		}
+	}
+
+	return ""
+}
+
+// resolve follows $ref and single allOf wrappers to the real schema.
+func (s *Spec) resolve(schema *Schema) *Schema {
+	for range 10 { // avoid loops.
+		switch {
+		case schema == nil:
+			return nil
+		case schema.Ref != "":
+			schema = s.Components.Schemas[strings.TrimPrefix(schema.Ref, "#/components/schemas/")]
+		case len(schema.AllOf) == 1:
+			schema = schema.AllOf[0]
+		default:
+			return schema
+		}
+	}
+
+	return schema
+}
+
+func (s *Spec) compareStructs(pkg *goPackage, extra map[string]string) []*Drift {
+	drift := []*Drift{}
+	names := make([]string, 0, len(pkg.types))
+
+	for name := range pkg.types {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	for _, goName := range names {
+		if _, ok := pkg.types[goName].(*ast.StructType); !ok || !ast.IsExported(goName) {
+			continue
+		}
+
+		fields := make(map[string]ast.Expr)
+		pkg.jsonFields(pkg.types[goName], fields)
+
+		if schemaName := s.schemaFor(goName, fields["records"] != nil, extra); schemaName != "" {
+			drift = append(drift, s.compareStruct(pkg, goName, schemaName)...)
+		}
+	}
+
+	return drift
+}
+
+func (s *Spec) compareStruct(pkg *goPackage, goName, schemaName string) []*Drift {
+	schema := s.resolve(s.Components.Schemas[schemaName])
+	if schema == nil || len(schema.Properties) == 0 {
+		return nil
+	}
+
+	fields := make(map[string]ast.Expr)
+	pkg.jsonFields(pkg.types[goName], fields)
+
+	drift := []*Drift{}
+	props := make([]string, 0, len(schema.Properties))
+
+	for prop := range schema.Properties {
+		props = append(props, prop)
+	}
+
+	slices.Sort(props)
+
+	for _, prop := range props {
+		expr, ok := fields[prop]
+		if !ok {
+			drift = append(drift, &Drift{Kind: DriftMissingField, GoType: goName, Schema: schemaName, Field: prop})
+			continue
+		}
+
+		if detail := s.typeDrift(pkg, expr, schema.Properties[prop]); detail != "" {
+			drift = append(drift, &Drift{
+				Kind: DriftWrongType, GoType: goName, Schema: schemaName, Field: prop, Detail: detail,
+			})
+		}
+	}
+
+	for _, field := range sortedKeys(fields) {
+		if _, ok := schema.Properties[field]; !ok {
+			drift = append(drift, &Drift{Kind: DriftUnknownField, GoType: goName, Schema: schemaName, Field: field})
+		}
+	}
+
+	return drift
+}
+
+func sortedKeys[V any](input map[string]V) []string {
+	keys := make([]string, 0, len(input))
+	for key := range input {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
+
+// jsonFields collects the JSON field names (and their type expressions) from a struct,
+// including fields promoted from embedded structs in the same package.
+func (p *goPackage) jsonFields(expr ast.Expr, fields map[string]ast.Expr) {
+	structType, ok := expr.(*ast.StructType)
+	if !ok {
+		return
+	}
+
+	for _, field := range structType.Fields.List {
+		tag := ""
+		if field.Tag != nil {
+			tag, _ = strconv.Unquote(field.Tag.Value)
+		}
+
+		name, _, _ := strings.Cut(reflect.StructTag(tag).Get("json"), ",")
+
+		switch {
+		case name == "-":
+			continue
+		case len(field.Names) == 0 && name == "": // embedded.
+			if ident, ok := unstar(field.Type).(*ast.Ident); ok {
+				p.jsonFields(p.types[ident.Name], fields)
+			}
+		case name != "":
+			fields[name] = field.Type
+		default:
+			for _, ident := range field.Names {
+				if ident.IsExported() {
+					fields[ident.Name] = field.Type
+				}
+			}
+		}
+	}
+}
+
+func unstar(expr ast.Expr) ast.Expr {
+	for {
+		star, ok := expr.(*ast.StarExpr)
+		if !ok {
+			return expr
+		}
+
+		expr = star.X
+	}
+}
+
+// goKind reduces a Go type expression to a kind comparable with a spec type.
+// Named types are followed to their underlying type, and reported as "enum" if that's a string.
+func (p *goPackage) goKind(expr ast.Expr) string {
+	switch expr := unstar(expr).(type) {
+	case *ast.ArrayType:
+		if ident, ok := expr.Elt.(*ast.Ident); ok && ident.Name == "byte" {
+			return "string"
+		}
+
+		return "array"
+	case *ast.MapType, *ast.StructType:
+		return "object"
+	case *ast.InterfaceType:
+		return "any"
+	case *ast.SelectorExpr:
+		return selectorKind(expr)
+	case *ast.Ident:
+		switch expr.Name {
+		case "int", "int32", "int64", "string", "bool":
+			return expr.Name
+		case "uint", "uint32", "uint64", "int8", "int16", "uint8", "uint16":
+			return "int"
+		case "float32", "float64":
+			return "number"
+		case "any":
+			return "any"
+		}
+
+		underlying, ok := p.types[expr.Name]
+		if !ok {
+			return "any"
+		}
+
+		if kind := p.goKind(underlying); kind != "string" {
+			return kind
+		}
+
+		return "enum"
+	default:
+		return "any"
+	}
+}
+
+// selectorKind returns the kind for a type from another package.
+func selectorKind(expr *ast.SelectorExpr) string {
+	pkg, _ := expr.X.(*ast.Ident)
+	if pkg == nil {
+		return "any"
+	}
+
+	switch name := pkg.Name + "." + expr.Sel.Name; name {
+	case "time.Time":
+		return "date-time"
+	case "time.Duration":
+		return "int64"
+	case "starr.Protocol", "starr.ApplyTags", "starr.Sorting":
+		return "enum"
+	case "starr.Filtering":
+		return "int"
+	case "json.RawMessage":
+		return "any"
+	default:
+		return "object"
+	}
+}
+
+// specKind reduces a spec schema to a kind comparable with a Go type.
+func (s *Spec) specKind(schema *Schema) string {
+	schema = s.resolve(schema)
+
+	switch {
+	case schema == nil:
+		return "any"
+	case len(schema.Enum) > 0:
+		return "enum"
+	case schema.Type == "integer" && schema.Format == "int64":
+		return "int64"
+	case schema.Type == "integer":
+		return "int32"
+	case schema.Type == "string" && schema.Format == "date-time":
+		return "date-time"
+	case schema.Type == "object", len(schema.Properties) > 0:
+		return "object"
+	case schema.Type == "":
+		return "any"
+	default:
+		return schema.Type // string, number, boolean, array
+	}
+}
+
+// typeDrift returns a description if the Go type does not match the spec type.
+func (s *Spec) typeDrift(pkg *goPackage, expr ast.Expr, schema *Schema) string {
+	goKind, specKind := pkg.goKind(expr), s.specKind(schema)
+
+	if goKind == "any" || specKind == "any" || compatible(goKind, specKind) {
+		return ""
+	}
+
+	return goKind + " vs " + specKind
+}
+
+// compatible returns true if a Go kind can safely hold a spec kind.
+// An int is 32 bits on some platforms, so it only holds int32.
+func compatible(goKind, specKind string) bool {
+	switch specKind {
+	case "int32":
+		return goKind == "int" || goKind == "int32" || goKind == "int64"
+	case "int64":
+		return goKind == "int64"
+	case "number":
+		return goKind == "number"
+	case "boolean":
+		return goKind == "bool"
+	case "string":
+		return goKind == "string" || goKind == "enum"
+	case "enum":
+		return goKind == "enum"
+	case "date-time":
+		return goKind == "date-time"
+	case "array":
+		return goKind == "array"
+	case "object":
+		return goKind == "object"
+	default:
+		return false
+	}
+}
+
+// uncoveredPaths returns every spec path that no function in the package appears to call.
+// A path is covered when one function references every literal segment of the path,
+// through string literals or package constants (like bpMovie = APIver + "/movie").
+func (s *Spec) uncoveredPaths(pkg *goPackage) []*Drift {
+	funcs := make([]map[string]bool, 0, len(pkg.funcs))
+	for _, fn := range pkg.funcs {
+		funcs = append(funcs, pkg.funcSegments(fn))
+	}
+
+	drift := []*Drift{}
+
+	for _, uri := range sortedKeys(s.Paths) {
+		segments := pathSegments(uri)
+		if len(segments) == 0 {
+			continue
+		}
+
+		if !slices.ContainsFunc(funcs, func(have map[string]bool) bool {
+			for _, segment := range segments {
+				if !have[segment] {
+					return false
+				}
+			}
+
+			return true
+		}) {
+			methods := sortedKeys(s.Paths[uri])
+			drift = append(drift, &Drift{
+				Kind: DriftUncoveredPath, Schema: uri, Detail: strings.ToUpper(strings.Join(methods, ",")),
+			})
+		}
+	}
+
+	return drift
+}
+
+// pathSegments returns the literal (non-parameter) segments of a spec path, without the api prefix.
+func pathSegments(uri string) []string {
+	segments := []string{}
+
+	for idx, segment := range strings.Split(strings.Trim(uri, "/"), "/") {
+		if (idx == 0 && segment == "api") || segment == "" || strings.HasPrefix(segment, "{") {
+			continue
+		}
+
+		segments = append(segments, strings.ToLower(segment))
+	}
+
+	return segments
+}
+
+// funcSegments returns every path segment a function references through strings and constants.
+func (p *goPackage) funcSegments(fn *ast.FuncDecl) map[string]bool {
+	segments := make(map[string]bool)
+
+	if fn.Body == nil {
+		return segments
+	}
+
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		var value string
+
+		switch node := node.(type) {
+		case *ast.BasicLit:
+			value = p.constString(node, 0)
+		case *ast.Ident:
+			if expr, ok := p.consts[node.Name]; ok {
+				value = p.constString(expr, 0)
+			}
+		}
+
+		for segment := range strings.SplitSeq(value, "/") {
+			if segment != "" {
+				segments[strings.ToLower(segment)] = true
+			}
+		}
+
+		return true
+	})
+
+	return segments
+}
+
+// constString evaluates a simple string constant expression, like APIver + "/movie".
+func (p *goPackage) constString(expr ast.Expr, depth int) string {
+	if depth > 10 { //nolint:mnd // avoid loops.
+		return ""
+	}
+
+	switch expr := expr.(type) {
+	case *ast.BasicLit:
+		if expr.Kind != token.STRING {
+			return ""
+		}
+
+		value, _ := strconv.Unquote(expr.Value)
+
+		return value
+	case *ast.Ident:
+		if value, ok := p.consts[expr.Name]; ok {
+			return p.constString(value, depth+1)
+		}
+	case *ast.BinaryExpr:
+		if expr.Op == token.ADD {
+			return p.constString(expr.X, depth+1) + p.constString(expr.Y, depth+1)
+		}
+	case *ast.ParenExpr:
+		return p.constString(expr.X, depth+1)
+	}
+
+	return ""
+}
+
+// SpecDriftEnv is the environment variable that controls ReportSpecDrift.
+// Set it to "log" to log every drift, or "fail" to also fail the test.
+const SpecDriftEnv = "STARR_SPEC_DRIFT"
+
+// ReportSpecDrift runs SpecDrift on the package in the current directory against the bundled spec for app.
+// The specs always contain more than this library wraps, so the test is skipped unless the
+// STARR_SPEC_DRIFT environment variable is set. `make specdrift` sets it to log every drift.
+func ReportSpecDrift(t *testing.T, app string, extra map[string]string) {
+	t.Helper()
+
+	mode := os.Getenv(SpecDriftEnv)
+	if mode == "" {
+		t.Skipf("set %s=log (or fail) to compare with the %s spec, or run make specdrift", SpecDriftEnv, app)
+	}
+
+	specFile, err := FindSpec(".", app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	drift, err := SpecDrift(".", specFile, extra)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	counts := make(map[DriftKind]int)
+
+	for _, item := range drift {
+		counts[item.Kind]++
+
+		t.Log(item)
+	}
+
+	t.Logf("%s spec drift (%s): %d missing fields, %d unknown fields, %d wrong types, %d uncovered paths",
+		app, filepath.Base(specFile), counts[DriftMissingField], counts[DriftUnknownField],
+		counts[DriftWrongType], counts[DriftUncoveredPath])
+
+	if mode == "fail" && len(drift) > 0 {
+		t.Errorf("%d differences found between %s and the Go source", len(drift), filepath.Base(specFile))
+	}
+}
@@ -0,0 +1,136 @@
+package starrtest_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr/starrtest"
+)
+
+// writeDrift writes a Go package and a spec into a temp folder, and runs SpecDrift on them.
+func writeDrift(t *testing.T, source, spec string) []*starrtest.Drift {
+	t.Helper()
+
+	dir := t.TempDir()
+	specFile := filepath.Join(dir, "app.json")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app.go"), []byte("package app\n\n"+source), 0o600))
+	require.NoError(t, os.WriteFile(specFile, []byte(spec), 0o600))
+
+	drift, err := starrtest.SpecDrift(dir, specFile, nil)
+	require.NoError(t, err)
+
+	return drift
+}
+
+func TestSpecDriftTypes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		goType string
+		schema string
+		detail string // Empty means no drift.
+	}{
+		{name: "int is too small", goType: "int", schema: `{"type":"integer","format":"int64"}`, detail: "int vs int64"},
+		{name: "int holds int32", goType: "int", schema: `{"type":"integer","format":"int32"}`},
+		{name: "int64 holds int32", goType: "int64", schema: `{"type":"integer","format":"int32"}`},
+		{name: "int64 holds int64", goType: "int64", schema: `{"type":"integer","format":"int64"}`},
+		{name: "int32 is too small", goType: "int32", schema: `{"type":"integer","format":"int64"}`,
+			detail: "int32 vs int64"},
+		{name: "string is not an integer", goType: "string", schema: `{"type":"integer"}`, detail: "string vs int32"},
+		{name: "float", goType: "float64", schema: `{"type":"number","format":"double"}`},
+		{name: "int is not a float", goType: "int", schema: `{"type":"number"}`, detail: "int vs number"},
+		{name: "bool", goType: "bool", schema: `{"type":"boolean"}`},
+		{name: "date", goType: "time.Time", schema: `{"type":"string","format":"date-time"}`},
+		{name: "date as string", goType: "string", schema: `{"type":"string","format":"date-time"}`,
+			detail: "string vs date-time"},
+		{name: "enum", goType: "Kind", schema: `{"$ref":"#/components/schemas/Kind"}`},
+		{name: "plain string for an enum", goType: "string", schema: `{"$ref":"#/components/schemas/Kind"}`,
+			detail: "string vs enum"},
+		{name: "enum for a string", goType: "Kind", schema: `{"type":"string"}`},
+		{name: "array", goType: "[]string", schema: `{"type":"array","items":{"type":"string"}}`},
+		{name: "array is not an object", goType: "[]int", schema: `{"type":"object"}`, detail: "array vs object"},
+		{name: "any", goType: "any", schema: `{"type":"integer"}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			drift := writeDrift(t,
+				"import \"time\"\n\nvar _ time.Time\n\ntype Kind string\n\n"+
+					"type Thing struct {\n\tField "+test.goType+" `json:\"field\"`\n}\n",
+				`{"components":{"schemas":{"Kind":{"type":"string","enum":["a","b"]},`+
+					`"ThingResource":{"type":"object","properties":{"field":`+test.schema+`}}}}}`)
+
+			if test.detail == "" {
+				assert.Empty(t, drift)
+				return
+			}
+
+			assert.Equal(t, []*starrtest.Drift{{
+				Kind: starrtest.DriftWrongType, GoType: "Thing", Schema: "ThingResource", Field: "field", Detail: test.detail,
+			}}, drift)
+		})
+	}
+}
+
+func TestSpecDriftFields(t *testing.T) {
+	t.Parallel()
+
+	drift := writeDrift(t, `
+type base struct {
+	ID int64 `+"`json:\"id\"`"+`
+}
+
+type ThingOutput struct {
+	base
+	Name   string `+"`json:\"name\"`"+`
+	Extra  string `+"`json:\"extra\"`"+`
+	Hidden string `+"`json:\"-\"`"+`
+}
+
+type NotInSpec struct {
+	Anything string `+"`json:\"anything\"`"+`
+}
+`, `{"components":{"schemas":{"ThingResource":{"type":"object","properties":{
+		"id":{"type":"integer","format":"int32"},"name":{"type":"string"},"size":{"type":"integer"}}}}}}`)
+
+	assert.Equal(t, []*starrtest.Drift{
+		{Kind: starrtest.DriftMissingField, GoType: "ThingOutput", Schema: "ThingResource", Field: "size"},
+		{Kind: starrtest.DriftUnknownField, GoType: "ThingOutput", Schema: "ThingResource", Field: "extra"},
+	}, drift, "embedded fields count, and types without a schema are skipped")
+}
+
+func TestSpecDriftPaths(t *testing.T) {
+	t.Parallel()
+
+	drift := writeDrift(t, `
+const (
+	APIver  = "v3"
+	bpThing = APIver + "/thing"
+)
+
+func GetThing() string {
+	return bpThing
+}
+
+func GetThingStatus() string {
+	return bpThing + "/status"
+}
+`, `{"paths":{
+		"/api/v3/thing":{"get":{}},
+		"/api/v3/thing/{id}":{"get":{},"put":{}},
+		"/api/v3/thing/status":{"get":{}},
+		"/api/v3/other/{id}":{"delete":{},"get":{}}
+	}}`)
+
+	assert.Equal(t, []*starrtest.Drift{
+		{Kind: starrtest.DriftUncoveredPath, Schema: "/api/v3/other/{id}", Detail: "DELETE,GET"},
+	}, drift)
+	assert.Equal(t, "uncovered path: /api/v3/other/{id} DELETE,GET", drift[0].String())
+}
@@ -0,0 +1,573 @@
+package starrtest
+
+/* This file contains a stateful, in-memory fake of the Sonarr and Radarr v3 APIs.
+ * It's meant for workflow tests (add series, search, check the queue) that need more than
+ * one canned response. Resources are stored as generic JSON objects, so any field the client
+ * sends is returned unchanged. Only the routes below are implemented; the rest return 404.
+ */
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"golift.io/starr"
+)
+
+// FakeAPIKey is the API key a FakeServer accepts if another is not provided.
+const FakeAPIKey = "fakeAPIkey"
+
+// Resource names used by FakeServer. Use these with Seed and Get.
+const (
+	FakeSeries         = "series"
+	FakeMovie          = "movie"
+	FakeTag            = "tag"
+	FakeQualityProfile = "qualityprofile"
+	FakeCommand        = "command"
+	FakeQueue          = "queue"
+	// These are used by configuration tools, like starrconfig.
+	FakeCustomFormat      = "customformat"
+	FakeQualityDefinition = "qualitydefinition"
+	FakeDelayProfile      = "delayprofile"
+	FakeReleaseProfile    = "releaseprofile"
+	FakeIndexer           = "indexer"
+	FakeDownloadClient    = "downloadclient"
+	FakeNotification      = "notification"
+	// These settings are a single object. Seed them once; GET returns an empty object until then.
+	FakeNaming          = "config/naming"
+	FakeMediaManagement = "config/mediamanagement"
+)
+
+// Command statuses a FakeServer moves through, one step per GET of the command.
+var fakeCommandStatus = []string{"queued", "started", "completed"} //nolint:gochecknoglobals
+
+// FakeObject is a single resource stored in a FakeServer.
+type FakeObject = map[string]any
+
+// FakeServer is a stateful, in-memory fake Sonarr or Radarr server. Create one with NewFakeServer.
+// Supported routes, all under /api/v3 and all requiring the X-Api-Key header (or apikey parameter):
+//   - GET, POST /{resource}, and GET, PUT, DELETE /{resource}/{id} for series (Sonarr) or
+//     movie (Radarr), tag, qualityprofile, customformat, qualitydefinition, delayprofile,
+//     releaseprofile, indexer, downloadclient and notification.
+//   - GET, PUT /config/naming and /config/mediamanagement.
+//   - GET, POST /command and GET, DELETE /command/{id}. Commands move from queued to started to
+//     completed each time they're fetched. Search commands add items to the queue.
+//   - GET /queue (paged), DELETE /queue/{id}, and GET /system/status.
+//
+// GET /ping also works, without an API key.
+type FakeServer struct {
+	*httptest.Server
+
+	App    starr.App
+	APIKey string
+
+	mu        sync.Mutex
+	resources map[string][]FakeObject
+	lastID    map[string]int64
+}
+
+// NewFakeServer starts a fake Sonarr or Radarr server. It's closed when the test finishes.
+// Pass the result's URL and APIKey into starr.New, and then into sonarr.New or radarr.New.
+func NewFakeServer(t testing.TB, app starr.App, apiKey string) *FakeServer {
+	t.Helper()
+
+	if apiKey == "" {
+		apiKey = FakeAPIKey
+	}
+
+	fake := &FakeServer{
+		App:       app,
+		APIKey:    apiKey,
+		resources: make(map[string][]FakeObject),
+		lastID:    make(map[string]int64),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /ping", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, FakeObject{"status": "OK"})
+	})
+	mux.Handle("/api/v3/", fake.auth(fake.routes()))
+	mux.HandleFunc("/", func(w http.ResponseWriter, _ *http.Request) {
+		writeRaw(w, http.StatusNotFound, BodyNotFound)
+	})
+
+	fake.Server = httptest.NewServer(mux)
+	t.Cleanup(fake.Close)
+
+	return fake
+}
+
+// mediaResource returns the name of the main media resource for the app.
+func (f *FakeServer) mediaResource() string {
+	if f.App == starr.Radarr {
+		return FakeMovie
+	}
+
+	return FakeSeries
+}
+
+func (f *FakeServer) routes() *http.ServeMux {
+	mux := http.NewServeMux()
+	base := "/api/v3/"
+
+	for _, name := range []string{
+		f.mediaResource(), FakeTag, FakeQualityProfile, FakeCustomFormat, FakeQualityDefinition,
+		FakeDelayProfile, FakeReleaseProfile, FakeIndexer, FakeDownloadClient, FakeNotification,
+	} {
+		mux.HandleFunc("GET "+base+name, f.list(name))
+		mux.HandleFunc("POST "+base+name, f.create(name))
+		mux.HandleFunc("GET "+base+name+"/{id}", f.get(name))
+		mux.HandleFunc("PUT "+base+name+"/{id}", f.update(name))
+		mux.HandleFunc("DELETE "+base+name+"/{id}", f.delete(name))
+	}
+
+	for _, name := range []string{FakeNaming, FakeMediaManagement} {
+		mux.HandleFunc("GET "+base+name, f.setting(name))
+		mux.HandleFunc("PUT "+base+name, f.setting(name))
+		mux.HandleFunc("PUT "+base+name+"/{id}", f.setting(name))
+	}
+
+	mux.HandleFunc("GET "+base+FakeCommand, f.list(FakeCommand))
+	mux.HandleFunc("POST "+base+FakeCommand, f.command)
+	mux.HandleFunc("GET "+base+FakeCommand+"/{id}", f.commandStatus)
+	mux.HandleFunc("DELETE "+base+FakeCommand+"/{id}", f.delete(FakeCommand))
+	mux.HandleFunc("GET "+base+FakeQueue, f.queue)
+	mux.HandleFunc("DELETE "+base+FakeQueue+"/{id}", f.delete(FakeQueue))
+	mux.HandleFunc("GET "+base+"system/status", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, FakeObject{"appName": f.App.String(), "version": "0.0.0.0", "isDebug": true})
+	})
+	mux.HandleFunc(base, func(w http.ResponseWriter, _ *http.Request) {
+		writeRaw(w, http.StatusNotFound, BodyNotFound)
+	})
+
+	return mux
+}
+
+// auth checks the API key, like the real apps do. Paths are also made case-insensitive here.
+func (f *FakeServer) auth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("X-Api-Key") != f.APIKey && req.URL.Query().Get("apikey") != f.APIKey {
+			writeRaw(w, http.StatusUnauthorized, BodyUnauthorized)
+			return
+		}
+
+		req.URL.Path = strings.ToLower(req.URL.Path)
+
+		next.ServeHTTP(w, req)
+	})
+}
+
+// Seed stores objects in the fake server as if they were created with a POST, and returns their IDs.
+// Objects may be any type that encodes to a JSON object, like a *sonarr.Series or a starr.Tag.
+func (f *FakeServer) Seed(resource string, objects ...any) ([]int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ids := make([]int64, 0, len(objects))
+
+	for _, object := range objects {
+		data, err := json.Marshal(object)
+		if err != nil {
+			return ids, fmt.Errorf("encoding %s: %w", resource, err)
+		}
+
+		var obj FakeObject
+		if err := json.Unmarshal(data, &obj); err != nil {
+			return ids, fmt.Errorf("%s must be a JSON object: %w", resource, err)
+		}
+
+		ids = append(ids, f.add(resource, obj))
+	}
+
+	return ids, nil
+}
+
+// Get returns a copy of every object stored for a resource, like FakeQueue or FakeTag.
+func (f *FakeServer) Get(resource string) []FakeObject {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return slices.Clone(f.resources[resource])
+}
+
+// add stores an object with a new ID. The lock must be held.
+func (f *FakeServer) add(resource string, obj FakeObject) int64 {
+	f.lastID[resource]++
+	obj["id"] = f.lastID[resource]
+	f.resources[resource] = append(f.resources[resource], obj)
+
+	return f.lastID[resource]
+}
+
+// find returns the index of an object by ID, or -1. The lock must be held.
+func (f *FakeServer) find(resource string, id int64) int {
+	return slices.IndexFunc(f.resources[resource], func(obj FakeObject) bool {
+		return toInt64(obj["id"]) == id
+	})
+}
+
+func (f *FakeServer) list(resource string) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		output := []FakeObject{}
+
+		// Sonarr filters series by tvdbId, and Radarr filters movies by tmdbId.
+		for _, obj := range f.resources[resource] {
+			if match(obj, req, "tvdbId") && match(obj, req, "tmdbId") {
+				output = append(output, obj)
+			}
+		}
+
+		writeJSON(w, http.StatusOK, output)
+	}
+}
+
+// match returns true if the query parameter is empty, or matches the object's value.
+func match(obj FakeObject, req *http.Request, param string) bool {
+	value := req.URL.Query().Get(param)
+	return value == "" || value == "0" || toInt64(obj[param]) == toInt64(value)
+}
+
+func (f *FakeServer) get(resource string) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		idx := f.find(resource, pathID(req))
+		if idx < 0 {
+			writeRaw(w, http.StatusNotFound, BodyNotFound)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, f.resources[resource][idx])
+	}
+}
+
+func (f *FakeServer) create(resource string) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		obj, ok := readObject(w, req)
+		if !ok {
+			return
+		}
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		if errs := f.validate(resource, obj, 0); len(errs) > 0 {
+			writeJSON(w, http.StatusBadRequest, errs)
+			return
+		}
+
+		f.add(resource, obj)
+		writeJSON(w, http.StatusCreated, obj)
+	}
+}
+
+func (f *FakeServer) update(resource string) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		obj, ok := readObject(w, req)
+		if !ok {
+			return
+		}
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		id := pathID(req)
+
+		idx := f.find(resource, id)
+		if idx < 0 {
+			writeRaw(w, http.StatusNotFound, BodyNotFound)
+			return
+		}
+
+		if errs := f.validate(resource, obj, id); len(errs) > 0 {
+			writeJSON(w, http.StatusBadRequest, errs)
+			return
+		}
+
+		obj["id"] = id
+		f.resources[resource][idx] = obj
+		writeJSON(w, http.StatusAccepted, obj)
+	}
+}
+
+func (f *FakeServer) delete(resource string) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		idx := f.find(resource, pathID(req))
+		if idx < 0 {
+			writeRaw(w, http.StatusNotFound, BodyNotFound)
+			return
+		}
+
+		f.resources[resource] = slices.Delete(f.resources[resource], idx, idx+1)
+		writeJSON(w, http.StatusOK, FakeObject{})
+	}
+}
+
+// setting handles GET and PUT for a resource that is a single object.
+func (f *FakeServer) setting(resource string) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		var obj FakeObject
+
+		if req.Method == http.MethodPut {
+			var ok bool
+			if obj, ok = readObject(w, req); !ok {
+				return
+			}
+		}
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		if obj == nil {
+			if len(f.resources[resource]) == 0 {
+				f.add(resource, FakeObject{})
+			}
+
+			writeJSON(w, http.StatusOK, f.resources[resource][0])
+
+			return
+		}
+
+		obj["id"] = int64(1)
+		f.resources[resource] = []FakeObject{obj}
+		writeJSON(w, http.StatusAccepted, obj)
+	}
+}
+
+// validate returns the same kind of property errors the real apps return. The lock must be held.
+func (f *FakeServer) validate(resource string, obj FakeObject, id int64) []*starr.ValidationError {
+	errs := []*starr.ValidationError{}
+	invalid := func(prop, msg, code string) {
+		errs = append(errs, &starr.ValidationError{
+			PropertyName: prop, ErrorMessage: msg, AttemptedValue: obj[prop], Severity: "error", ErrorCode: code,
+		})
+	}
+
+	switch resource {
+	case FakeTag:
+		if obj["label"] == nil || obj["label"] == "" {
+			invalid("Label", "'Label' must not be empty.", "NotEmptyValidator")
+		}
+	case FakeQualityProfile, FakeCustomFormat, FakeIndexer, FakeDownloadClient, FakeNotification:
+		if obj["name"] == nil || obj["name"] == "" {
+			invalid("Name", "'Name' must not be empty.", "NotEmptyValidator")
+		}
+	case FakeSeries, FakeMovie:
+		if obj["title"] == nil || obj["title"] == "" {
+			invalid("Title", "'Title' must not be empty.", "NotEmptyValidator")
+		}
+
+		if profile := toInt64(obj["qualityProfileId"]); f.find(FakeQualityProfile, profile) < 0 {
+			invalid("QualityProfileId", "Quality Profile does not exist", "QualityProfileExistsValidator")
+		}
+
+		key := map[string]string{FakeSeries: "tvdbId", FakeMovie: "tmdbId"}[resource]
+		if dupe := slices.IndexFunc(f.resources[resource], func(have FakeObject) bool {
+			return toInt64(have["id"]) != id && toInt64(have[key]) != 0 && toInt64(have[key]) == toInt64(obj[key])
+		}); dupe >= 0 {
+			invalid(strings.ToUpper(key[:1])+key[1:], "This "+resource+" has already been added", "AlreadyExistsValidator")
+		}
+	}
+
+	return errs
+}
+
+// command handles POST /command. Search commands add records to the queue.
+func (f *FakeServer) command(w http.ResponseWriter, req *http.Request) {
+	body, ok := readObject(w, req)
+	if !ok {
+		return
+	}
+
+	name, _ := body["name"].(string)
+	if name == "" {
+		writeJSON(w, http.StatusBadRequest, []*starr.ValidationError{{
+			PropertyName: "Name", ErrorMessage: "Unknown command", Severity: "error", ErrorCode: "CommandNameValidator",
+		}})
+
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := time.Now().UTC()
+	obj := FakeObject{
+		"name": name, "commandName": name, "body": body, "priority": "normal", "status": fakeCommandStatus[0],
+		"queued": now, "trigger": "manual", "sendUpdatesToClient": true, "updateScheduledTask": true,
+	}
+
+	f.add(FakeCommand, obj)
+	f.search(name, body)
+	writeJSON(w, http.StatusCreated, obj)
+}
+
+// search adds a queue record for every item in a search command. The lock must be held.
+func (f *FakeServer) search(name string, body FakeObject) {
+	media := f.mediaResource()
+	ids := []int64{}
+
+	switch name {
+	case "SeriesSearch", "SeasonSearch":
+		ids = append(ids, toInt64(body["seriesId"]))
+	case "MoviesSearch":
+		for _, id := range toSlice(body["movieIds"]) {
+			ids = append(ids, toInt64(id))
+		}
+	case "EpisodeSearch":
+		for _, episode := range toSlice(body["episodeIds"]) {
+			f.add(FakeQueue, fakeQueueRecord(FakeObject{"episodeId": episode, "title": "Episode " + fmt.Sprint(episode)}))
+		}
+
+		return
+	default:
+		return
+	}
+
+	for _, id := range ids {
+		if idx := f.find(media, id); idx >= 0 {
+			f.add(FakeQueue, fakeQueueRecord(FakeObject{media + "Id": id, "title": f.resources[media][idx]["title"]}))
+		}
+	}
+}
+
+func fakeQueueRecord(record FakeObject) FakeObject {
+	const size = 1024 * 1024 * 1024
+
+	record["status"] = "downloading"
+	record["trackedDownloadStatus"] = "ok"
+	record["trackedDownloadState"] = "downloading"
+	record["protocol"] = "torrent"
+	record["downloadClient"] = "Fake Client"
+	record["indexer"] = "Fake Indexer"
+	record["size"] = size
+	record["sizeleft"] = size / 2 //nolint:mnd
+	record["timeleft"] = "00:10:00"
+
+	return record
+}
+
+// commandStatus returns a command, and moves it to the next status.
+func (f *FakeServer) commandStatus(w http.ResponseWriter, req *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	idx := f.find(FakeCommand, pathID(req))
+	if idx < 0 {
+		writeRaw(w, http.StatusNotFound, BodyNotFound)
+		return
+	}
+
+	obj := f.resources[FakeCommand][idx]
+	now := time.Now().UTC()
+
+	switch status := slices.Index(fakeCommandStatus, fmt.Sprint(obj["status"])); status {
+	case 0:
+		obj["started"] = now
+		obj["status"] = fakeCommandStatus[1]
+	case 1:
+		obj["ended"] = now
+		obj["status"] = fakeCommandStatus[2]
+		obj["message"] = "Completed"
+	}
+
+	obj["stateChangeTime"] = now
+	writeJSON(w, http.StatusOK, obj)
+}
+
+// queue handles the paged GET /queue route.
+func (f *FakeServer) queue(w http.ResponseWriter, req *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	page, _ := strconv.Atoi(req.URL.Query().Get("page"))
+	page = max(page, 1)
+
+	size, _ := strconv.Atoi(req.URL.Query().Get("pageSize"))
+	if size < 1 {
+		size = 10
+	}
+
+	records := f.resources[FakeQueue]
+	start := min((page-1)*size, len(records))
+	end := min(start+size, len(records))
+
+	writeJSON(w, http.StatusOK, FakeObject{
+		"page":          page,
+		"pageSize":      size,
+		"sortKey":       req.URL.Query().Get("sortKey"),
+		"sortDirection": req.URL.Query().Get("sortDirection"),
+		"totalRecords":  len(records),
+		"records":       append([]FakeObject{}, records[start:end]...),
+	})
+}
+
+// readObject decodes a JSON object request body, or writes an error.
+func readObject(w http.ResponseWriter, req *http.Request) (FakeObject, bool) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		writeRaw(w, http.StatusBadRequest, `{"message":"`+err.Error()+`"}`)
+		return nil, false
+	}
+
+	var obj FakeObject
+	if err := json.Unmarshal(body, &obj); err != nil || obj == nil {
+		writeJSON(w, http.StatusBadRequest, FakeObject{"message": "Request body must be a JSON object"})
+		return nil, false
+	}
+
+	return obj, true
+}
+
+func pathID(req *http.Request) int64 {
+	id, _ := strconv.ParseInt(req.PathValue("id"), 10, 64)
+	return id
+}
+
+func toInt64(value any) int64 {
+	switch value := value.(type) {
+	case float64:
+		return int64(value)
+	case int64:
+		return value
+	case int:
+		return int64(value)
+	case string:
+		id, _ := strconv.ParseInt(value, 10, 64)
+		return id
+	default:
+		return 0
+	}
+}
+
+func toSlice(value any) []any {
+	slice, _ := value.([]any)
+	return slice
+}
+
+func writeJSON(w http.ResponseWriter, status int, output any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+func writeRaw(w http.ResponseWriter, status int, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(body))
+}
@@ -0,0 +1,145 @@
+package starrtest
+
+/* This file contains a RoundTripper that replays cassettes made with debuglog.RecordingRoundTripper.
+ * Users can record a cassette against their own server, attach it to a bug report,
+ * and we can turn it into a regression test without a running Starr app.
+ */
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+
+	"golift.io/starr/debuglog"
+)
+
+// ErrNoInteraction is returned when a replayed request is not in the cassette.
+var ErrNoInteraction = errors.New("no matching interaction in cassette")
+
+// ReplayRoundTripper serves the responses recorded in a cassette.
+// Requests are matched by method, path and query; the apikey parameter and the host are ignored.
+// Each recorded interaction is served once, in the order it was recorded.
+type ReplayRoundTripper struct {
+	// Compare request bodies too. Bodies with redacted values will not match.
+	MatchBody bool
+
+	cassette *debuglog.Cassette
+	mu       sync.Mutex
+	used     []bool
+}
+
+// NewReplayRoundTripper loads a cassette file and returns a round tripper that replays it.
+func NewReplayRoundTripper(path string) (*ReplayRoundTripper, error) {
+	cassette, err := debuglog.LoadCassette(path)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // it's already wrapped.
+	}
+
+	return NewCassetteReplayer(cassette), nil
+}
+
+// NewCassetteReplayer returns a round tripper that replays an already-loaded cassette.
+func NewCassetteReplayer(cassette *debuglog.Cassette) *ReplayRoundTripper {
+	return &ReplayRoundTripper{cassette: cassette, used: make([]bool, len(cassette.Interactions))}
+}
+
+// Client returns an http client that uses this round tripper. Pass it into starr.New.
+func (r *ReplayRoundTripper) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Remaining returns the number of recorded interactions that have not been replayed.
+func (r *ReplayRoundTripper) Remaining() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	count := 0
+
+	for _, used := range r.used {
+		if !used {
+			count++
+		}
+	}
+
+	return count
+}
+
+// AssertDone fails the test if any recorded interaction was not replayed.
+func (r *ReplayRoundTripper) AssertDone(t *testing.T) {
+	t.Helper()
+
+	if remaining := r.Remaining(); remaining > 0 {
+		t.Errorf("%d of %d cassette interactions were not replayed", remaining, len(r.used))
+	}
+}
+
+// RoundTrip satisfies the http.RoundTripper interface.
+func (r *ReplayRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var sent []byte
+
+	if req.Body != nil {
+		var err error
+		if sent, err = io.ReadAll(req.Body); err != nil {
+			return nil, fmt.Errorf("reading request body: %w", err)
+		}
+
+		_ = req.Body.Close()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for idx, interaction := range r.cassette.Interactions {
+		if r.used[idx] || !r.matches(interaction.Request, req, sent) {
+			continue
+		}
+
+		r.used[idx] = true
+		recorded := interaction.Response
+
+		header := recorded.Header.Clone()
+		if header == nil {
+			header = make(http.Header)
+		}
+
+		return &http.Response{
+			Status:        recorded.Status,
+			StatusCode:    recorded.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader([]byte(recorded.Body))),
+			ContentLength: int64(len(recorded.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s %s", ErrNoInteraction, req.Method, req.URL.RequestURI())
+}
+
+func (r *ReplayRoundTripper) matches(recorded *debuglog.RecordedRequest, req *http.Request, sent []byte) bool {
+	if recorded.Method != req.Method {
+		return false
+	}
+
+	uri, err := url.Parse(recorded.URL)
+	if err != nil || uri.Path != req.URL.Path {
+		return false
+	}
+
+	want, have := uri.Query(), req.URL.Query()
+	want.Del("apikey")
+	have.Del("apikey")
+
+	if want.Encode() != have.Encode() {
+		return false
+	}
+
+	return !r.MatchBody || bytes.Equal(bytes.TrimSpace([]byte(recorded.Body)), bytes.TrimSpace(sent))
+}
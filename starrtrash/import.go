@@ -0,0 +1,137 @@
+package starrtrash
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"golift.io/starr"
+)
+
+/* This file has the import logic shared by Sonarr and Radarr. The app files wire
+ * each app's custom format and quality profile methods into an app value.
+ */
+
+// app holds one app's custom format and quality profile calls.
+// Input and Output are the custom format types; Profile is the quality profile type.
+type app[Input, Output, Profile any] struct {
+	// input converts a guide format to the app's input, with the ID of the format to update (or 0).
+	input         func(format *CustomFormat, id int64) Input
+	format        func(output Output) (name string, id int64)
+	getFormats    func(ctx context.Context) ([]Output, error)
+	addFormat     func(ctx context.Context, input Input) (Output, error)
+	updateFormat  func(ctx context.Context, input Input) (Output, error)
+	profile       func(profile Profile) (name string, items *[]*starr.FormatItem)
+	getProfiles   func(ctx context.Context) ([]Profile, error)
+	updateProfile func(ctx context.Context, profile Profile) error
+}
+
+// importFormats adds or updates custom formats, matching existing formats by name,
+// then sets their scores in the quality profiles in opts.
+func (a *app[Input, Output, Profile]) importFormats(
+	ctx context.Context, formats []*CustomFormat, opts *Options,
+) ([]*Imported, error) {
+	if err := uniqueNames(formats); err != nil {
+		return nil, err
+	}
+
+	ids, err := a.formatIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	imported := make([]*Imported, len(formats))
+
+	for idx, format := range formats {
+		id := ids[format.Name]
+		imported[idx] = &Imported{TrashID: format.TrashID, Name: format.Name, Created: id == 0}
+
+		var output Output
+		if id == 0 {
+			output, err = a.addFormat(ctx, a.input(format, id))
+		} else {
+			output, err = a.updateFormat(ctx, a.input(format, id))
+		}
+
+		if err != nil {
+			return imported[:idx], fmt.Errorf("custom format %q: %w", format.Name, err)
+		}
+
+		_, imported[idx].ID = a.format(output)
+	}
+
+	if len(opts.profiles()) == 0 {
+		return imported, nil
+	}
+
+	return imported, a.score(ctx, imported, formats, opts)
+}
+
+// uniqueNames returns an error if two formats have the same name.
+func uniqueNames(formats []*CustomFormat) error {
+	seen := make(map[string]bool, len(formats))
+
+	for _, format := range formats {
+		if seen[format.Name] {
+			return fmt.Errorf("%w: %q is imported twice", ErrDuplicateName, format.Name)
+		}
+
+		seen[format.Name] = true
+	}
+
+	return nil
+}
+
+// formatIDs returns the ID of every custom format in the app, by name.
+func (a *app[Input, Output, Profile]) formatIDs(ctx context.Context) (map[string]int64, error) {
+	existing, err := a.getFormats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting custom formats: %w", err)
+	}
+
+	ids := make(map[string]int64, len(existing))
+
+	for _, format := range existing {
+		name, id := a.format(format)
+		if _, ok := ids[name]; ok {
+			return nil, fmt.Errorf("%w: %q exists twice in the app", ErrDuplicateName, name)
+		}
+
+		ids[name] = id
+	}
+
+	return ids, nil
+}
+
+// score sets the imported format scores in the quality profiles in opts.
+func (a *app[Input, Output, Profile]) score(
+	ctx context.Context, imported []*Imported, formats []*CustomFormat, opts *Options,
+) error {
+	profiles, err := a.getProfiles(ctx)
+	if err != nil {
+		return fmt.Errorf("getting quality profiles: %w", err)
+	}
+
+	for _, name := range opts.profiles() {
+		idx := slices.IndexFunc(profiles, func(profile Profile) bool {
+			found, _ := a.profile(profile)
+			return found == name
+		})
+		if idx == -1 {
+			return fmt.Errorf("%w: %s", ErrNoProfile, name)
+		}
+
+		var changed bool
+
+		_, items := a.profile(profiles[idx])
+		if *items, changed = setScores(*items, imported, formats, opts.scoreSet()); !changed {
+			continue
+		}
+
+		if err := a.updateProfile(ctx, profiles[idx]); err != nil {
+			return fmt.Errorf("quality profile %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
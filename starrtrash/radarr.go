@@ -0,0 +1,58 @@
+package starrtrash
+
+import (
+	"context"
+
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+)
+
+// Radarr converts the custom format into the input for radarr.AddCustomFormat.
+func (c *CustomFormat) Radarr() *radarr.CustomFormatInput {
+	output := &radarr.CustomFormatInput{
+		Name:                  c.Name,
+		IncludeCFWhenRenaming: c.IncludeCFWhenRenaming,
+		Specifications:        make([]*radarr.CustomFormatInputSpec, len(c.Specifications)),
+	}
+
+	for idx, spec := range c.Specifications {
+		output.Specifications[idx] = &radarr.CustomFormatInputSpec{
+			Name:           spec.Name,
+			Implementation: spec.Implementation,
+			Negate:         spec.Negate,
+			Required:       spec.Required,
+			Fields:         spec.Fields.Input(),
+		}
+	}
+
+	return output
+}
+
+// ImportRadarr adds or updates custom formats in Radarr, matching existing formats by name.
+// When opts has profiles, the format scores are set in those quality profiles.
+// The returned list is in the same order as the input formats. Nothing is changed if two
+// formats have the same name, in the input or in the app; that returns ErrDuplicateName.
+func ImportRadarr(ctx context.Context, client *radarr.Radarr,
+	formats []*CustomFormat, opts *Options,
+) ([]*Imported, error) {
+	return (&app[*radarr.CustomFormatInput, *radarr.CustomFormatOutput, *radarr.QualityProfile]{
+		input: func(format *CustomFormat, id int64) *radarr.CustomFormatInput {
+			input := format.Radarr()
+			input.ID = id
+
+			return input
+		},
+		format:       func(output *radarr.CustomFormatOutput) (string, int64) { return output.Name, output.ID },
+		getFormats:   client.GetCustomFormatsContext,
+		addFormat:    client.AddCustomFormatContext,
+		updateFormat: client.UpdateCustomFormatContext,
+		profile: func(profile *radarr.QualityProfile) (string, *[]*starr.FormatItem) {
+			return profile.Name, &profile.FormatItems
+		},
+		getProfiles: client.GetQualityProfilesContext,
+		updateProfile: func(ctx context.Context, profile *radarr.QualityProfile) error {
+			_, err := client.UpdateQualityProfileContext(ctx, profile)
+			return err //nolint:wrapcheck // the caller wraps it.
+		},
+	}).importFormats(ctx, formats, opts)
+}
@@ -0,0 +1,58 @@
+package starrtrash
+
+import (
+	"context"
+
+	"golift.io/starr"
+	"golift.io/starr/sonarr"
+)
+
+// Sonarr converts the custom format into the input for sonarr.AddCustomFormat.
+func (c *CustomFormat) Sonarr() *sonarr.CustomFormatInput {
+	output := &sonarr.CustomFormatInput{
+		Name:                  c.Name,
+		IncludeCFWhenRenaming: c.IncludeCFWhenRenaming,
+		Specifications:        make([]*sonarr.CustomFormatInputSpec, len(c.Specifications)),
+	}
+
+	for idx, spec := range c.Specifications {
+		output.Specifications[idx] = &sonarr.CustomFormatInputSpec{
+			Name:           spec.Name,
+			Implementation: spec.Implementation,
+			Negate:         spec.Negate,
+			Required:       spec.Required,
+			Fields:         spec.Fields.Input(),
+		}
+	}
+
+	return output
+}
+
+// ImportSonarr adds or updates custom formats in Sonarr, matching existing formats by name.
+// When opts has profiles, the format scores are set in those quality profiles.
+// The returned list is in the same order as the input formats. Nothing is changed if two
+// formats have the same name, in the input or in the app; that returns ErrDuplicateName.
+func ImportSonarr(ctx context.Context, client *sonarr.Sonarr,
+	formats []*CustomFormat, opts *Options,
+) ([]*Imported, error) {
+	return (&app[*sonarr.CustomFormatInput, *sonarr.CustomFormatOutput, *sonarr.QualityProfile]{
+		input: func(format *CustomFormat, id int64) *sonarr.CustomFormatInput {
+			input := format.Sonarr()
+			input.ID = id
+
+			return input
+		},
+		format:       func(output *sonarr.CustomFormatOutput) (string, int64) { return output.Name, output.ID },
+		getFormats:   client.GetCustomFormatsContext,
+		addFormat:    client.AddCustomFormatContext,
+		updateFormat: client.UpdateCustomFormatContext,
+		profile: func(profile *sonarr.QualityProfile) (string, *[]*starr.FormatItem) {
+			return profile.Name, &profile.FormatItems
+		},
+		getProfiles: client.GetQualityProfilesContext,
+		updateProfile: func(ctx context.Context, profile *sonarr.QualityProfile) error {
+			_, err := client.UpdateQualityProfileContext(ctx, profile)
+			return err //nolint:wrapcheck // the caller wraps it.
+		},
+	}).importFormats(ctx, formats, opts)
+}
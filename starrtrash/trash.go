@@ -0,0 +1,218 @@
+// Package starrtrash imports custom formats from TRaSH guide JSON files into Sonarr and Radarr.
+// Get the files from https://github.com/TRaSH-Guides/Guides under docs/json/{radarr,sonarr}/cf.
+//
+// Guide files store specification fields as an object ({"value": "x265"}), and carry extra
+// trash_* keys with scores for quality profiles. This package converts them into the library's
+// CustomFormatInput types, adds or updates them by name, and can set their scores in quality profiles.
+package starrtrash
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"golift.io/starr"
+)
+
+// DefaultScoreSet is the score set used when Options.ScoreSet is empty.
+// Every guide file with a score has one in this set.
+const DefaultScoreSet = "default"
+
+// Errors returned by the import functions.
+var (
+	// ErrNoProfile is returned when a quality profile in Options.Profiles does not exist.
+	ErrNoProfile = errors.New("quality profile not found")
+	// ErrDuplicateName is returned when two custom formats have the same name, in the import or in the app.
+	// Formats are matched by name, so one would overwrite the other.
+	ErrDuplicateName = errors.New("more than one custom format has the same name")
+)
+
+// CustomFormat is one custom format from a TRaSH guide JSON file.
+type CustomFormat struct {
+	TrashID               string           `json:"trash_id"`
+	TrashScores           map[string]int64 `json:"trash_scores,omitempty"`
+	TrashRegex            string           `json:"trash_regex,omitempty"`
+	TrashDescription      string           `json:"trash_description,omitempty"`
+	Name                  string           `json:"name"`
+	IncludeCFWhenRenaming bool             `json:"includeCustomFormatWhenRenaming"`
+	Specifications        []*Specification `json:"specifications"`
+}
+
+// Specification is part of a CustomFormat.
+type Specification struct {
+	Name           string `json:"name"`
+	Implementation string `json:"implementation"`
+	Negate         bool   `json:"negate"`
+	Required       bool   `json:"required"`
+	Fields         Fields `json:"fields"`
+}
+
+// Fields are a specification's field values by field name.
+// Both the guide layout (an object) and the API layout (a list of names and values) are decoded.
+type Fields map[string]any
+
+// Options control how custom formats are imported.
+type Options struct {
+	// Profiles are the names of quality profiles to set format scores in. Empty sets no scores.
+	Profiles []string
+	// ScoreSet is the key in each format's trash_scores to use, like "sqp-1-1080p".
+	// Formats without a score in this set use their default score. Those without either are not scored.
+	ScoreSet string
+}
+
+// Imported is one custom format that was added or updated.
+type Imported struct {
+	TrashID string
+	Name    string
+	ID      int64 // The custom format ID in the app.
+	Created bool  // False if an existing format was updated.
+}
+
+// Read decodes a single guide custom format.
+func Read(input io.Reader) (*CustomFormat, error) {
+	var format CustomFormat
+	if err := json.NewDecoder(input).Decode(&format); err != nil {
+		return nil, fmt.Errorf("decoding custom format: %w", err)
+	}
+
+	return &format, nil
+}
+
+// ReadFile decodes a guide custom format from a file.
+func ReadFile(name string) (*CustomFormat, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("opening custom format: %w", err)
+	}
+	defer file.Close()
+
+	format, err := Read(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	return format, nil
+}
+
+// ReadDir decodes every .json file in a directory, like a checkout of docs/json/radarr/cf.
+func ReadDir(dir string) ([]*CustomFormat, error) {
+	names, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("listing custom formats: %w", err)
+	}
+
+	formats := make([]*CustomFormat, 0, len(names))
+
+	for _, name := range names {
+		format, err := ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+
+		formats = append(formats, format)
+	}
+
+	return formats, nil
+}
+
+// Score returns the format's score in a score set, or its default score.
+func (c *CustomFormat) Score(set string) (int64, bool) {
+	if score, ok := c.TrashScores[set]; ok {
+		return score, true
+	}
+
+	score, ok := c.TrashScores[DefaultScoreSet]
+
+	return score, ok
+}
+
+// UnmarshalJSON decodes fields from an object or a list.
+func (f *Fields) UnmarshalJSON(input []byte) error {
+	var object map[string]any
+	if err := json.Unmarshal(input, &object); err == nil {
+		*f = object
+		return nil
+	}
+
+	var list []*starr.FieldInput
+	if err := json.Unmarshal(input, &list); err != nil {
+		return fmt.Errorf("fields are not an object or list: %w", err)
+	}
+
+	*f = make(Fields, len(list))
+	for _, field := range list {
+		(*f)[field.Name] = field.Value
+	}
+
+	return nil
+}
+
+// Input returns the fields as the list the apps accept, sorted by name.
+func (f Fields) Input() []*starr.FieldInput {
+	output := make([]*starr.FieldInput, 0, len(f))
+
+	for _, name := range slices.Sorted(maps.Keys(f)) {
+		value := f[name]
+		// The guides use floats for sizes and whole numbers for IDs. JSON decodes both as float64.
+		if number, ok := value.(float64); ok && number == float64(int64(number)) {
+			value = int64(number)
+		}
+
+		output = append(output, &starr.FieldInput{Name: name, Value: value})
+	}
+
+	return output
+}
+
+// scoreSet returns the score set to use.
+func (o *Options) scoreSet() string {
+	if o == nil || o.ScoreSet == "" {
+		return DefaultScoreSet
+	}
+
+	return o.ScoreSet
+}
+
+func (o *Options) profiles() []string {
+	if o == nil {
+		return nil
+	}
+
+	return o.Profiles
+}
+
+// setScores adds or updates the score for each imported format in a profile's format items.
+// Returns false if no score changed.
+func setScores(items []*starr.FormatItem, imported []*Imported,
+	formats []*CustomFormat, set string,
+) ([]*starr.FormatItem, bool) {
+	changed := false
+
+	for idx, format := range formats {
+		score, ok := format.Score(set)
+		if !ok {
+			continue
+		}
+
+		id := imported[idx].ID
+		found := slices.IndexFunc(items, func(item *starr.FormatItem) bool { return item.Format == id })
+
+		switch {
+		case found == -1:
+			items = append(items, &starr.FormatItem{Format: id, Name: format.Name, Score: score})
+		case items[found].Score == score:
+			continue
+		default:
+			items[found].Score = score
+		}
+
+		changed = true
+	}
+
+	return items, changed
+}
@@ -0,0 +1,118 @@
+package starrtrash_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrtest"
+	"golift.io/starr/starrtrash"
+)
+
+func TestReadDir(t *testing.T) {
+	t.Parallel()
+
+	formats, err := starrtrash.ReadDir("testdata")
+	require.NoError(t, err)
+	require.Len(t, formats, 2)
+
+	// Files are read in name order. The second file uses the API's field layout.
+	assert.Equal(t, &radarr.CustomFormatInput{
+		Name:                  "Repack/Proper",
+		IncludeCFWhenRenaming: true,
+		Specifications: []*radarr.CustomFormatInputSpec{{
+			Name:           "Repack",
+			Implementation: "QualityModifierSpecification",
+			Fields:         []*starr.FieldInput{{Name: "value", Value: int64(5)}},
+		}},
+	}, formats[0].Radarr())
+
+	input := formats[1].Sonarr()
+	assert.Equal(t, "x265 (HD)", input.Name)
+	require.Len(t, input.Specifications, 2)
+	assert.True(t, input.Specifications[1].Negate)
+	assert.Equal(t, []*starr.FieldInput{{Name: "value", Value: int64(2160)}}, input.Specifications[1].Fields)
+
+	score, ok := formats[1].Score("sqp-5")
+	assert.True(t, ok)
+	assert.Zero(t, score)
+	score, ok = formats[1].Score("missing")
+	assert.True(t, ok, "the default score must be used")
+	assert.Equal(t, int64(-10000), score)
+	_, ok = formats[0].Score(starrtrash.DefaultScoreSet)
+	assert.False(t, ok)
+}
+
+func TestImportRadarr(t *testing.T) {
+	t.Parallel()
+
+	fake := starrtest.NewFakeServer(t, starr.Radarr, "")
+	client := radarr.New(starr.New(fake.APIKey, fake.URL, 0))
+
+	_, err := fake.Seed(starrtest.FakeCustomFormat, radarr.CustomFormatInput{Name: "x265 (HD)"})
+	require.NoError(t, err)
+	_, err = fake.Seed(starrtest.FakeQualityProfile,
+		radarr.QualityProfile{Name: "HD-1080p", FormatItems: []*starr.FormatItem{{Format: 1, Name: "x265 (HD)"}}})
+	require.NoError(t, err)
+
+	formats, err := starrtrash.ReadDir("testdata")
+	require.NoError(t, err)
+
+	imported, err := starrtrash.ImportRadarr(t.Context(), client, formats,
+		&starrtrash.Options{Profiles: []string{"HD-1080p"}, ScoreSet: "sqp-1-1080p"})
+	require.NoError(t, err)
+	assert.Equal(t, []*starrtrash.Imported{
+		{TrashID: "e6258996055b9fbab7e9cb2f75819294", Name: "Repack/Proper", ID: 2, Created: true},
+		{TrashID: "dc98083864ea246d05a42df0d05f81cc", Name: "x265 (HD)", ID: 1},
+	}, imported)
+
+	format, err := client.GetCustomFormatContext(t.Context(), 1)
+	require.NoError(t, err)
+	require.Len(t, format.Specifications, 2)
+	assert.Equal(t, "ReleaseTitleSpecification", format.Specifications[0].Implementation)
+
+	// The repack format has no score, so only the existing x265 item is changed.
+	profile, err := client.GetQualityProfileContext(t.Context(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, []*starr.FormatItem{{Format: 1, Name: "x265 (HD)", Score: -10000}}, profile.FormatItems)
+
+	_, err = starrtrash.ImportRadarr(t.Context(), client, formats, &starrtrash.Options{Profiles: []string{"Nope"}})
+	require.ErrorIs(t, err, starrtrash.ErrNoProfile)
+
+	// Two guide formats with the same name must not overwrite each other.
+	_, err = starrtrash.ImportRadarr(t.Context(), client, append(formats, formats[0]), nil)
+	require.ErrorIs(t, err, starrtrash.ErrDuplicateName)
+
+	// Neither can two formats with the same name in the app.
+	_, err = fake.Seed(starrtest.FakeCustomFormat, radarr.CustomFormatInput{Name: "x265 (HD)"})
+	require.NoError(t, err)
+	_, err = starrtrash.ImportRadarr(t.Context(), client, formats, nil)
+	require.ErrorIs(t, err, starrtrash.ErrDuplicateName)
+}
+
+func TestImportSonarr(t *testing.T) {
+	t.Parallel()
+
+	fake := starrtest.NewFakeServer(t, starr.Sonarr, "")
+	client := sonarr.New(starr.New(fake.APIKey, fake.URL, 0))
+
+	_, err := fake.Seed(starrtest.FakeQualityProfile, sonarr.QualityProfile{Name: "WEB-1080p"})
+	require.NoError(t, err)
+
+	format, err := starrtrash.ReadFile("testdata/x265-hd.json")
+	require.NoError(t, err)
+
+	imported, err := starrtrash.ImportSonarr(t.Context(), client, []*starrtrash.CustomFormat{format},
+		&starrtrash.Options{Profiles: []string{"WEB-1080p"}})
+	require.NoError(t, err)
+	require.Len(t, imported, 1)
+	assert.True(t, imported[0].Created)
+
+	profile, err := client.GetQualityProfileContext(t.Context(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, []*starr.FormatItem{{Format: imported[0].ID, Name: "x265 (HD)", Score: -10000}},
+		profile.FormatItems)
+}
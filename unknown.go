@@ -0,0 +1,232 @@
+package starr
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+/* This file contains helpers to preserve JSON fields that are not modeled in this library.
+ * Resource types that are commonly read, modified and written back (like movies and series)
+ * use these so an Update* call never drops data the app knows about, but this library does not.
+ */
+
+// UnknownFields holds JSON keys (and their raw values) that a struct does not model.
+// They're captured when the struct is decoded, and sent back when it's encoded.
+// You may add or change entries to send fields this library does not support yet.
+type UnknownFields map[string]json.RawMessage
+
+// knownFields caches the lower-cased JSON keys for each struct type.
+//
+//nolint:gochecknoglobals // this is a cache.
+var knownFields sync.Map
+
+// DecodeUnknown unmarshals data into output, and returns every JSON key that output does not model.
+// Output must be a pointer to a struct. It should be an alias type without its own UnmarshalJSON method.
+// Returns nil if there are no unknown keys. This is used by UnmarshalJSON methods in the starr modules.
+// The data is only decoded once. The unknown keys are found with a quick scan of the top level object.
+func DecodeUnknown(data []byte, output any) (UnknownFields, error) {
+	if err := json.Unmarshal(data, output); err != nil {
+		return nil, err //nolint:wrapcheck // the json package wraps the type for us.
+	}
+
+	known := jsonKeys(reflect.TypeOf(output))
+
+	var unknown UnknownFields
+
+	// The data is valid JSON, or the unmarshal above failed.
+	scanObject(data, func(key string, val []byte) {
+		if known[strings.ToLower(key)] {
+			return
+		}
+
+		if unknown == nil {
+			unknown = make(UnknownFields)
+		}
+
+		unknown[key] = slices.Clone(val) // the caller may reuse data.
+	})
+
+	return unknown, nil
+}
+
+// EncodeUnknown marshals input, and appends every unknown key that input does not already model.
+// Input must be a struct, or a pointer to one. It should be an alias type without its own MarshalJSON method.
+// Unknown keys are appended in sorted order. This is used by MarshalJSON methods in the starr modules.
+// Put the MarshalJSON method on the value receiver, so a value marshals with its unknown keys too.
+func EncodeUnknown(input any, unknown UnknownFields) ([]byte, error) {
+	data, err := json.Marshal(input)
+	if err != nil || len(unknown) == 0 {
+		return data, err //nolint:wrapcheck // the json package wraps the type for us.
+	}
+
+	known := jsonKeys(reflect.TypeOf(input))
+	keys := make([]string, 0, len(unknown))
+
+	for key := range unknown {
+		if !known[strings.ToLower(key)] {
+			keys = append(keys, key)
+		}
+	}
+
+	if len(keys) == 0 || !bytes.HasSuffix(data, []byte("}")) {
+		return data, nil
+	}
+
+	slices.Sort(keys)
+
+	buf := bytes.NewBuffer(data[:len(data)-1])
+
+	for idx, key := range keys {
+		if idx > 0 || len(data) > 2 { //nolint:mnd // 2 is an empty object: {}
+			buf.WriteByte(',')
+		}
+
+		name, _ := json.Marshal(key)
+		buf.Write(name)
+		buf.WriteByte(':')
+
+		if val := unknown[key]; len(val) > 0 {
+			buf.Write(val)
+		} else {
+			buf.WriteString("null")
+		}
+	}
+
+	buf.WriteByte('}')
+
+	if !json.Valid(buf.Bytes()) {
+		return nil, fmt.Errorf("%w: invalid unknown field value", ErrRequestError)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// jsonKeys returns the lower-cased JSON keys for a struct type, including promoted fields.
+func jsonKeys(typ reflect.Type) map[string]bool {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	if cached, ok := knownFields.Load(typ); ok {
+		return cached.(map[string]bool) //nolint:forcetypeassert // we control the cache.
+	}
+
+	keys := make(map[string]bool)
+	addJSONKeys(typ, keys)
+	knownFields.Store(typ, keys)
+
+	return keys
+}
+
+func addJSONKeys(typ reflect.Type, keys map[string]bool) {
+	if typ.Kind() != reflect.Struct {
+		return
+	}
+
+	for idx := range typ.NumField() {
+		field := typ.Field(idx)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+
+		switch {
+		case name == "" && field.Anonymous:
+			fieldType := field.Type
+			if fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+
+			addJSONKeys(fieldType, keys)
+		case name == "" && field.IsExported():
+			keys[strings.ToLower(field.Name)] = true
+		case name != "":
+			keys[strings.ToLower(name)] = true
+		}
+	}
+}
+
+// scanObject calls found with each key and raw value in a JSON object. Data must be valid JSON.
+// Nothing is found if data is not an object.
+func scanObject(data []byte, found func(key string, val []byte)) {
+	idx := skipSpace(data, 0)
+	if idx >= len(data) || data[idx] != '{' {
+		return
+	}
+
+	for idx = skipSpace(data, idx+1); idx < len(data) && data[idx] == '"'; idx = skipSpace(data, idx+1) {
+		end := skipString(data, idx) + 1
+		if end > len(data) {
+			return
+		}
+
+		key := string(data[idx+1 : end-1])
+
+		if bytes.IndexByte(data[idx:end], '\\') >= 0 {
+			_ = json.Unmarshal(data[idx:end], &key) // escaped key.
+		}
+
+		start := skipSpace(data, skipSpace(data, end)+1) // skip the colon.
+		end = skipValue(data, start)
+		found(key, data[start:end])
+
+		if idx = skipSpace(data, end); idx >= len(data) || data[idx] != ',' {
+			return
+		}
+	}
+}
+
+// skipSpace returns the index of the next non-whitespace byte.
+func skipSpace(data []byte, idx int) int {
+	for idx < len(data) && (data[idx] == ' ' || data[idx] == '\t' || data[idx] == '\n' || data[idx] == '\r') {
+		idx++
+	}
+
+	return idx
+}
+
+// skipString returns the index of the quote that ends the string starting at idx.
+func skipString(data []byte, idx int) int {
+	for idx++; idx < len(data) && data[idx] != '"'; idx++ {
+		if data[idx] == '\\' {
+			idx++
+		}
+	}
+
+	return idx
+}
+
+// skipValue returns the index just past the JSON value that starts at idx.
+func skipValue(data []byte, idx int) int {
+	depth := 0
+
+	for ; idx < len(data); idx++ {
+		switch data[idx] {
+		case '"':
+			if idx = skipString(data, idx); depth == 0 {
+				return idx + 1
+			}
+		case '{', '[':
+			depth++
+		case '}', ']':
+			if depth--; depth == 0 {
+				return idx + 1
+			} else if depth < 0 {
+				return idx // end of the parent object.
+			}
+		case ',', ' ', '\t', '\n', '\r':
+			if depth == 0 {
+				return idx
+			}
+		}
+	}
+
+	return idx
+}
@@ -0,0 +1,127 @@
+package starr_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+)
+
+type testResource struct {
+	starr.Tag
+
+	Title   string              `json:"title"`
+	Year    int                 `json:"year,omitempty"`
+	Unknown starr.UnknownFields `json:"-"`
+}
+
+func (r *testResource) UnmarshalJSON(data []byte) error {
+	type resource testResource
+
+	unknown, err := starr.DecodeUnknown(data, (*resource)(r))
+	r.Unknown = unknown
+
+	return err
+}
+
+func (r testResource) MarshalJSON() ([]byte, error) {
+	type resource testResource
+	return starr.EncodeUnknown(resource(r), r.Unknown)
+}
+
+func TestUnknownFields(t *testing.T) {
+	t.Parallel()
+
+	input := `{"id":1,"label":"tag","title":"Some Title","newField":{"a":[1,2]},"Year":2020,"another":true}`
+
+	var resource testResource
+
+	require.NoError(t, json.Unmarshal([]byte(input), &resource))
+	assert.Equal(t, 1, resource.ID, "embedded fields must be decoded")
+	assert.Equal(t, 2020, resource.Year, "keys must match without case sensitivity")
+	assert.Equal(t, starr.UnknownFields{
+		"newField": json.RawMessage(`{"a":[1,2]}`),
+		"another":  json.RawMessage(`true`),
+	}, resource.Unknown)
+
+	resource.Title = "New Title"
+
+	output, err := json.Marshal(&resource)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":1,"label":"tag","title":"New Title","year":2020,"newField":{"a":[1,2]},"another":true}`,
+		string(output))
+	assert.Equal(t, `{"id":1,"label":"tag","title":"New Title","year":2020,"another":true,"newField":{"a":[1,2]}}`,
+		string(output), "unknown fields must be appended in sorted order")
+}
+
+func TestUnknownFieldsEmpty(t *testing.T) {
+	t.Parallel()
+
+	var resource testResource
+
+	require.NoError(t, json.Unmarshal([]byte(`{"title":"Title"}`), &resource))
+	assert.Nil(t, resource.Unknown, "unknown must be nil when every field is modeled")
+
+	// A modeled field in Unknown must not create a duplicate key.
+	resource.Unknown = starr.UnknownFields{"title": json.RawMessage(`"Other"`)}
+
+	output, err := json.Marshal(&resource)
+	require.NoError(t, err)
+	assert.Equal(t, `{"label":"","title":"Title"}`, string(output))
+
+	// An invalid raw value must not produce invalid JSON.
+	resource.Unknown = starr.UnknownFields{"bad": json.RawMessage(`{`)}
+	_, err = json.Marshal(&resource)
+	require.Error(t, err)
+}
+
+func TestUnknownFieldsValue(t *testing.T) {
+	t.Parallel()
+
+	var resource testResource
+
+	require.NoError(t, json.Unmarshal([]byte(`{"title":"Title","newField":1}`), &resource))
+
+	// Values, and values inside slices and maps, must keep their unknown fields too.
+	output, err := json.Marshal(resource)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"label":"","title":"Title","newField":1}`, string(output))
+
+	output, err = json.Marshal([]testResource{resource})
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"label":"","title":"Title","newField":1}]`, string(output))
+
+	output, err = json.Marshal(map[string]testResource{"key": resource})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"key":{"label":"","title":"Title","newField":1}}`, string(output))
+
+	output, err = json.Marshal((*testResource)(nil))
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(output))
+}
+
+func TestUnknownFieldsScan(t *testing.T) {
+	t.Parallel()
+
+	input := "{\n  \"title\" : \"a \\\"}{\\\" b\",\n  \"list\": [ {\"x\": \"]\"}, 2 ],\n" +
+		"  \"obj\":{\"nested\":{\"title\":1}} , \"\\u0061scii\" : null,\"num\":-1.5e3, \"year\":1\n}"
+
+	var resource testResource
+
+	require.NoError(t, json.Unmarshal([]byte(input), &resource))
+	assert.Equal(t, `a "}{" b`, resource.Title)
+	assert.Equal(t, starr.UnknownFields{
+		"list":  json.RawMessage(`[ {"x": "]"}, 2 ]`),
+		"obj":   json.RawMessage(`{"nested":{"title":1}}`),
+		"ascii": json.RawMessage(`null`),
+		"num":   json.RawMessage(`-1.5e3`),
+	}, resource.Unknown, "only top level keys are unknown, and escaped keys are decoded")
+
+	for _, input := range []string{`null`, `{}`, ` { } `} {
+		resource = testResource{}
+		require.NoError(t, json.Unmarshal([]byte(input), &resource))
+		assert.Nil(t, resource.Unknown, input)
+	}
+}
@@ -0,0 +1,113 @@
+package starr
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+/* This file contains the validation error types that may be found inside a ReqError. */
+
+// ValidationError is a single property validation failure returned by a Starr app.
+// These are usually returned with a 400 status code when adding or updating a resource.
+type ValidationError struct {
+	PropertyName   string `json:"propertyName"`
+	ErrorMessage   string `json:"errorMessage"`
+	AttemptedValue any    `json:"attemptedValue,omitempty"`
+	Severity       string `json:"severity,omitempty"`
+	ErrorCode      string `json:"errorCode,omitempty"`
+	IsWarning      bool   `json:"isWarning,omitempty"`
+	InfoLink       string `json:"infoLink,omitempty"`
+}
+
+// ValidationErrors is a list of validation failures. It satisfies the error interface.
+type ValidationErrors []*ValidationError
+
+// Error returns the property name and message for a validation failure.
+func (v *ValidationError) Error() string {
+	if v.PropertyName == "" {
+		return v.ErrorMessage
+	}
+
+	return v.PropertyName + ": " + v.ErrorMessage
+}
+
+// Error joins every validation failure into one message. Nil entries are skipped.
+func (v ValidationErrors) Error() string {
+	msgs := make([]string, 0, len(v))
+
+	for _, err := range v {
+		if err != nil {
+			msgs = append(msgs, err.Error())
+		}
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// ValidationErrors returns every validation failure found in the response body. May be empty.
+func (r *ReqError) ValidationErrors() ValidationErrors {
+	return r.Errors
+}
+
+// As provides errors.As support for validation failures.
+// The target may be a *ValidationErrors to get every failure, or a **ValidationError to get the first one.
+// Returns false if the app did not return any validation failures.
+func (r *ReqError) As(target any) bool {
+	if len(r.Errors) == 0 {
+		return false
+	}
+
+	switch target := target.(type) {
+	case *ValidationErrors:
+		*target = r.Errors
+		return true
+	case **ValidationError:
+		*target = r.Errors[0]
+		return true
+	default:
+		return false
+	}
+}
+
+// GetValidationErrors returns the validation failures from any error that wraps a ReqError.
+// Returns nil if there are none.
+func GetValidationErrors(err error) ValidationErrors {
+	var reqErr *ReqError
+	if !errors.As(err, &reqErr) {
+		return nil
+	}
+
+	return reqErr.Errors
+}
+
+// String returns a multi-line description of every failure, including the attempted values.
+// Useful for showing a user everything that needs to be fixed at once. Nil entries are skipped.
+func (v ValidationErrors) String() string {
+	var buf strings.Builder
+
+	for _, err := range v {
+		if err == nil {
+			continue
+		}
+
+		severity := err.Severity
+		if severity == "" {
+			severity = "error"
+		}
+
+		fmt.Fprintf(&buf, "[%s] %s", severity, err.Error())
+
+		if err.AttemptedValue != nil {
+			fmt.Fprintf(&buf, " (attempted value: %v)", err.AttemptedValue)
+		}
+
+		if err.ErrorCode != "" {
+			fmt.Fprintf(&buf, " [%s]", err.ErrorCode)
+		}
+
+		buf.WriteString("\n")
+	}
+
+	return buf.String()
+}